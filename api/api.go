@@ -23,6 +23,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/getgauge/common"
@@ -163,9 +164,15 @@ func (handler *gaugeApiMessageHandler) MessageBytesReceived(bytesRead []byte, co
 		case gauge_messages.APIMessage_ExtractConceptRequest:
 			responseMessage = handler.extractConcept(apiMessage)
 			break
+		case gauge_messages.APIMessage_InlineConceptRequest:
+			responseMessage = handler.inlineConcept(apiMessage)
+			break
 		case gauge_messages.APIMessage_FormatSpecsRequest:
 			responseMessage = handler.formatSpecs(apiMessage)
 			break
+		case gauge_messages.APIMessage_FormatTextRequest:
+			responseMessage = handler.formatText(apiMessage)
+			break
 		default:
 			responseMessage = handler.createUnsupportedApiMessageResponse(apiMessage)
 		}
@@ -277,13 +284,20 @@ func (handler *gaugeApiMessageHandler) createGetAllConceptsResponseMessageFor(co
 func (handler *gaugeApiMessageHandler) performRefactoring(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
 	refactoringRequest := message.PerformRefactoringRequest
 	startChan := StartAPI()
-	refactoringResult := refactor.PerformRephraseRefactoring(refactoringRequest.GetOldStep(), refactoringRequest.GetNewStep(), startChan)
+	refactorSteps := refactor.PerformRephraseRefactoring
+	if refactoringRequest.GetPreview() {
+		refactorSteps = refactor.PreviewRephraseRefactoring
+	}
+	refactoringResult := refactorSteps(refactoringRequest.GetOldStep(), refactoringRequest.GetNewStep(), startChan)
 	if refactoringResult.Success {
 		logger.ApiLog.Info("%s", refactoringResult.String())
 	} else {
 		logger.ApiLog.Error("Refactoring response from gauge. Errors : %s", refactoringResult.Errors)
 	}
 	response := &gauge_messages.PerformRefactoringResponse{Success: proto.Bool(refactoringResult.Success), Errors: refactoringResult.Errors, FilesChanged: refactoringResult.AllFilesChanges()}
+	if refactoringRequest.GetPreview() {
+		response.FileChanges = refactoringResult.FileChanges()
+	}
 	return &gauge_messages.APIMessage{MessageId: message.MessageId, MessageType: gauge_messages.APIMessage_PerformRefactoringResponse.Enum(), PerformRefactoringResponse: response}
 }
 
@@ -294,6 +308,14 @@ func (handler *gaugeApiMessageHandler) extractConcept(message *gauge_messages.AP
 	return &gauge_messages.APIMessage{MessageId: message.MessageId, MessageType: gauge_messages.APIMessage_ExtractConceptResponse.Enum(), ExtractConceptResponse: response}
 }
 
+func (handler *gaugeApiMessageHandler) inlineConcept(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
+	request := message.GetInlineConceptRequest()
+	info := request.GetSelectedTextInfo()
+	result := refactor.InlineConcept(request.GetConceptName(), info.GetFileName(), int(info.GetStartingLineNo()), request.GetDeleteConcept(), false)
+	response := &gauge_messages.InlineConceptResponse{IsSuccess: proto.Bool(result.Success), Error: proto.String(strings.Join(result.Errors, "\n")), FilesChanged: result.AllFilesChanges()}
+	return &gauge_messages.APIMessage{MessageId: message.MessageId, MessageType: gauge_messages.APIMessage_InlineConceptResponse.Enum(), InlineConceptResponse: response}
+}
+
 func (handler *gaugeApiMessageHandler) formatSpecs(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
 	request := message.GetFormatSpecsRequest()
 	results := formatter.FormatSpecFiles(request.GetSpecs()...)
@@ -315,6 +337,30 @@ func (handler *gaugeApiMessageHandler) formatSpecs(message *gauge_messages.APIMe
 	return &gauge_messages.APIMessage{MessageId: message.MessageId, MessageType: gauge_messages.APIMessage_FormatSpecsResponse.Enum(), FormatSpecsResponse: formatResponse}
 }
 
+func (handler *gaugeApiMessageHandler) formatText(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
+	request := message.GetFormatTextRequest()
+	formatted, edits, result := formatter.FormatTextInRange(request.GetText(), request.GetFileName(), int(request.GetStartLine()), int(request.GetEndLine()))
+	formatResponse := &gauge_messages.FormatTextResponse{Errors: make([]string, 0), Warnings: make([]string, 0)}
+	if result.ParseError != nil {
+		formatResponse.Errors = append(formatResponse.Errors, result.ParseError.Error())
+	} else {
+		formatResponse.FormattedText = proto.String(formatted)
+		for _, edit := range edits {
+			formatResponse.TextEdits = append(formatResponse.TextEdits, convertToProtoTextEdit(edit))
+		}
+	}
+	for _, warning := range result.Warnings {
+		formatResponse.Warnings = append(formatResponse.Warnings, warning.String())
+	}
+	return &gauge_messages.APIMessage{MessageId: message.MessageId, MessageType: gauge_messages.APIMessage_FormatTextResponse.Enum(), FormatTextResponse: formatResponse}
+}
+
+func convertToProtoTextEdit(edit *formatter.TextEdit) *gauge_messages.TextEdit {
+	span := &gauge_messages.Span{StartLine: proto.Int32(int32(edit.Span.StartLine)), StartColumn: proto.Int32(int32(edit.Span.StartColumn)),
+		EndLine: proto.Int32(int32(edit.Span.EndLine)), EndColumn: proto.Int32(int32(edit.Span.EndColumn))}
+	return &gauge_messages.TextEdit{Span: span, NewText: proto.String(edit.NewText)}
+}
+
 func (handler *gaugeApiMessageHandler) createUnsupportedApiMessageResponse(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
 	return &gauge_messages.APIMessage{MessageId: message.MessageId,
 		MessageType:                   gauge_messages.APIMessage_UnsupportedApiMessageResponse.Enum(),
@@ -151,6 +151,7 @@ func copyGaugeFiles(installPath string) {
 	files[filepath.Join("skel", "hello_world.spec")] = filepath.Join("share", gauge, "skel")
 	files[filepath.Join("skel", "default.properties")] = filepath.Join("share", gauge, "skel", "env")
 	files[filepath.Join("skel", "gauge.properties")] = filepath.Join("share", gauge)
+	files[filepath.Join("skel", ".gitignore")] = filepath.Join("share", gauge, "skel")
 	files[filepath.Join("notice.md")] = filepath.Join("share", gauge)
 	files = addInstallScripts(files)
 	installFiles(files, installPath)
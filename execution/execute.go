@@ -24,6 +24,9 @@ func ExecuteSpecs(inParallel bool, args []string) int {
 	i.BufferUpdateDetails()
 	env.LoadEnv(false)
 	specsToExecute, conceptsDictionary := parseSpecs(args)
+	if err := validateTableRows(specsToExecute); err != nil {
+		logger.Fatal(err.Error())
+	}
 	manifest, err := manifest.ProjectManifest()
 	if err != nil {
 		logger.Fatal(err.Error())
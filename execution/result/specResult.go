@@ -156,17 +156,26 @@ func (specResult *SpecResult) AddScenarioResults(scenarioResults []*ScenarioResu
 	specResult.ScenarioCount += len(scenarioResults)
 }
 
-func (specResult *SpecResult) AddTableDrivenScenarioResult(scenarioResults [][](*ScenarioResult)) {
+// AddTableDrivenScenarioResult adds the results of a table driven spec. For every data table row it
+// holds the results of each scenario, more than one for a scenario driven by its own data table.
+func (specResult *SpecResult) AddTableDrivenScenarioResult(scenarioResults [][][](*ScenarioResult)) {
+	if len(scenarioResults) == 0 {
+		return
+	}
 	numberOfScenarios := len(scenarioResults[0])
 
 	for scenarioIndex := 0; scenarioIndex < numberOfScenarios; scenarioIndex++ {
 		protoTableDrivenScenario := &gauge_messages.ProtoTableDrivenScenario{Scenarios: make([]*gauge_messages.ProtoScenario, 0)}
 		scenarioFailed := false
 		for rowIndex, eachRow := range scenarioResults {
-			protoScenario := eachRow[scenarioIndex].ProtoScenario
-			protoTableDrivenScenario.Scenarios = append(protoTableDrivenScenario.GetScenarios(), protoScenario)
-			specResult.AddExecTime(protoScenario.GetExecutionTime())
-			if protoScenario.GetFailed() {
+			rowFailed := false
+			for _, scenarioResult := range eachRow[scenarioIndex] {
+				protoScenario := scenarioResult.ProtoScenario
+				protoTableDrivenScenario.Scenarios = append(protoTableDrivenScenario.GetScenarios(), protoScenario)
+				specResult.AddExecTime(protoScenario.GetExecutionTime())
+				rowFailed = rowFailed || protoScenario.GetFailed()
+			}
+			if rowFailed {
 				scenarioFailed = true
 				specResult.FailedDataTableRows = append(specResult.FailedDataTableRows, int32(rowIndex))
 			}
@@ -182,6 +191,25 @@ func (specResult *SpecResult) AddTableDrivenScenarioResult(scenarioResults [][](
 	specResult.ScenarioCount += numberOfScenarios
 }
 
+// AddScenarioTableDrivenResult adds the results of a scenario driven by its own data table, one for each row.
+func (specResult *SpecResult) AddScenarioTableDrivenResult(scenarioResults []*ScenarioResult) {
+	protoTableDrivenScenario := &gauge_messages.ProtoTableDrivenScenario{Scenarios: make([]*gauge_messages.ProtoScenario, 0)}
+	scenarioFailed := false
+	for _, scenarioResult := range scenarioResults {
+		protoScenario := scenarioResult.ProtoScenario
+		protoTableDrivenScenario.Scenarios = append(protoTableDrivenScenario.GetScenarios(), protoScenario)
+		specResult.AddExecTime(protoScenario.GetExecutionTime())
+		scenarioFailed = scenarioFailed || protoScenario.GetFailed()
+	}
+	if scenarioFailed {
+		specResult.ScenarioFailedCount++
+		specResult.IsFailed = true
+	}
+	protoItem := &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_TableDrivenScenario.Enum(), TableDrivenScenario: protoTableDrivenScenario}
+	specResult.ProtoSpec.Items = append(specResult.ProtoSpec.Items, protoItem)
+	specResult.ScenarioCount++
+}
+
 func (specResult *SpecResult) AddExecTime(execTime int64) {
 	specResult.ExecutionTime += execTime
 }
@@ -18,6 +18,7 @@
 package execution
 
 import (
+	"fmt"
 	"path/filepath"
 	"time"
 
@@ -159,15 +160,50 @@ func (exe *simpleExecution) start() *result.SuiteResult {
 	return exe.suiteResult
 }
 
-func getDataTableRows(rowCount int) indexRange {
+// getDataTableRows selects the rows given for the spec, or else the rows given by --table-rows. A predicate
+// given by --table-rows applies only to the specs whose table has all of its columns, the others run every row.
+func getDataTableRows(spec *parser.Specification) ([]int, error) {
+	table := &spec.DataTable.Table
+	if !table.IsInitialized() {
+		return nil, nil
+	}
+	allRows := indexRange{start: 0, end: table.GetRowCount() - 1}.rows()
+	if spec.TableRows != "" {
+		return selectDataTableRows(spec.TableRows, table)
+	}
 	if TableRows == "" {
-		return indexRange{start: 0, end: rowCount - 1}
+		return allRows, nil
 	}
-	indexes, err := getDataTableRowsRange(TableRows, rowCount)
-	if err != nil {
-		logger.Error("Table rows validation failed. %s\n", err.Error())
+	if isTableRowsPredicate(TableRows) {
+		conditions, err := parseTableRowsPredicate(TableRows)
+		if err != nil {
+			return nil, err
+		}
+		if !hasColumns(table, conditions) {
+			return allRows, nil
+		}
+	}
+	return selectDataTableRows(TableRows, table)
+}
+
+// validateTableRows checks the data table rows selected for each spec, so that an invalid selection fails
+// the run instead of executing no rows.
+func validateTableRows(specs []*parser.Specification) error {
+	for _, spec := range specs {
+		if _, err := getDataTableRows(spec); err != nil {
+			return fmt.Errorf("Table rows validation failed for %s. %s", spec.FileName, err.Error())
+		}
+	}
+	return nil
+}
+
+// selectDataTableRows selects rows by a numeric range like 2-4, or by a predicate on the columns like region=eu
+func selectDataTableRows(tableRows string, table *parser.Table) ([]int, error) {
+	if isTableRowsPredicate(tableRows) {
+		return getDataTableRowsByPredicate(tableRows, table)
 	}
-	return indexes
+	indexes, err := getDataTableRowsRange(tableRows, table.GetRowCount())
+	return indexes.rows(), err
 }
 
 func (exe *simpleExecution) finish() {
@@ -182,7 +218,7 @@ func (e *simpleExecution) stopAllPlugins() {
 	}
 }
 
-func newSpecExecutor(specToExecute *parser.Specification, runner *runner.TestRunner, pluginHandler *plugin.PluginHandler, tableRows indexRange, reporter reporter.Reporter, errMaps *validationErrMaps) *specExecutor {
+func newSpecExecutor(specToExecute *parser.Specification, runner *runner.TestRunner, pluginHandler *plugin.PluginHandler, tableRows []int, reporter reporter.Reporter, errMaps *validationErrMaps) *specExecutor {
 	specExecutor := new(specExecutor)
 	specExecutor.initialize(specToExecute, runner, pluginHandler, tableRows, reporter, errMaps)
 	return specExecutor
@@ -219,7 +255,11 @@ func (exe *simpleExecution) executeStream(specs *specList) *result.SuiteResult {
 }
 
 func (exe *simpleExecution) executeSpec(specificationToExecute *parser.Specification) {
-	executor := newSpecExecutor(specificationToExecute, exe.runner, exe.pluginHandler, getDataTableRows(specificationToExecute.DataTable.Table.GetRowCount()), exe.consoleReporter, exe.errMaps)
+	tableRows, err := getDataTableRows(specificationToExecute)
+	if err != nil {
+		logger.Error("Table rows validation failed. %s\n", err.Error())
+	}
+	executor := newSpecExecutor(specificationToExecute, exe.runner, exe.pluginHandler, tableRows, exe.consoleReporter, exe.errMaps)
 	protoSpecResult := executor.execute()
 	exe.suiteResult.AddSpecResult(protoSpecResult)
 }
@@ -37,13 +37,15 @@ import (
 
 type specExecutor struct {
 	specification        *parser.Specification
-	dataTableIndex       indexRange
+	dataTableRows        []int
 	runner               *runner.TestRunner
 	conceptDictionary    *parser.ConceptDictionary
 	pluginHandler        *plugin.PluginHandler
 	currentExecutionInfo *gauge_messages.ExecutionInfo
 	specResult           *result.SpecResult
 	currentTableRow      int
+	scenarioDataTable    *parser.Table
+	scenarioTableRow     int
 	consoleReporter      reporter.Reporter
 	errMap               *validationErrMaps
 }
@@ -53,11 +55,11 @@ type indexRange struct {
 	end   int
 }
 
-func (specExec *specExecutor) initialize(specificationToExecute *parser.Specification, runner *runner.TestRunner, pluginHandler *plugin.PluginHandler, tableRows indexRange, consoleReporter reporter.Reporter, errMap *validationErrMaps) {
+func (specExec *specExecutor) initialize(specificationToExecute *parser.Specification, runner *runner.TestRunner, pluginHandler *plugin.PluginHandler, tableRows []int, consoleReporter reporter.Reporter, errMap *validationErrMaps) {
 	specExec.specification = specificationToExecute
 	specExec.runner = runner
 	specExec.pluginHandler = pluginHandler
-	specExec.dataTableIndex = tableRows
+	specExec.dataTableRows = tableRows
 	specExec.consoleReporter = consoleReporter
 	specExec.errMap = errMap
 }
@@ -102,6 +104,10 @@ func (specExecutor *specExecutor) getSkippedSpecResult() *result.SpecResult {
 }
 
 func (s *specExecutor) getSkippedScenarioResult(scenario *parser.Scenario) *result.ScenarioResult {
+	if scenario.DataTable.IsInitialized() {
+		s.scenarioDataTable, s.scenarioTableRow = &scenario.DataTable.Table, 0
+		defer func() { s.scenarioDataTable = nil }()
+	}
 	scenarioResult := &result.ScenarioResult{parser.NewProtoScenario(scenario)}
 	s.addAllItemsForScenarioExecution(scenario, scenarioResult)
 	s.setSkipInfoInResult(scenarioResult, scenario)
@@ -128,8 +134,13 @@ func (specExecutor *specExecutor) execute() *result.SpecResult {
 	} else {
 		dataTableRowCount := specExecutor.specification.DataTable.Table.GetRowCount()
 		if dataTableRowCount == 0 {
-			scenarioResult := specExecutor.executeScenarios()
-			specExecutor.specResult.AddScenarioResults(scenarioResult)
+			for i, scenarioResults := range specExecutor.executeScenarios() {
+				if specExecutor.specification.Scenarios[i].DataTable.IsInitialized() {
+					specExecutor.specResult.AddScenarioTableDrivenResult(scenarioResults)
+				} else {
+					specExecutor.specResult.AddScenarioResults(scenarioResults)
+				}
+			}
 		} else {
 			specExecutor.executeTableDrivenSpec()
 		}
@@ -147,8 +158,8 @@ func (specExecutor *specExecutor) execute() *result.SpecResult {
 }
 
 func (specExecutor *specExecutor) executeTableDrivenSpec() {
-	var dataTableScenarioExecutionResult [][]*result.ScenarioResult
-	for specExecutor.currentTableRow = specExecutor.dataTableIndex.start; specExecutor.currentTableRow <= specExecutor.dataTableIndex.end; specExecutor.currentTableRow++ {
+	var dataTableScenarioExecutionResult [][][]*result.ScenarioResult
+	for _, specExecutor.currentTableRow = range specExecutor.dataTableRows {
 		var dataTable parser.Table
 		dataTable.AddHeaders(specExecutor.specification.DataTable.Table.Headers)
 		dataTable.AddRowValues(specExecutor.specification.DataTable.Table.Rows()[specExecutor.currentTableRow])
@@ -188,10 +199,28 @@ func (executor *specExecutor) executeAfterScenarioHook(scenarioResult *result.Sc
 	return executor.executeHook(message, scenarioResult)
 }
 
-func (specExecutor *specExecutor) executeScenarios() []*result.ScenarioResult {
-	scenarioResults := make([]*result.ScenarioResult, 0)
+// executeScenarios returns the results of every scenario, one for each row of its data table if it has one
+func (specExecutor *specExecutor) executeScenarios() [][]*result.ScenarioResult {
+	scenarioResults := make([][]*result.ScenarioResult, 0)
 	for _, scenario := range specExecutor.specification.Scenarios {
-		scenarioResults = append(scenarioResults, specExecutor.executeScenario(scenario))
+		scenarioResults = append(scenarioResults, specExecutor.executeScenarioForEachRow(scenario))
+	}
+	return scenarioResults
+}
+
+func (executor *specExecutor) executeScenarioForEachRow(scenario *parser.Scenario) []*result.ScenarioResult {
+	if !scenario.DataTable.IsInitialized() {
+		return []*result.ScenarioResult{executor.executeScenario(scenario)}
+	}
+	executor.scenarioDataTable = &scenario.DataTable.Table
+	defer func() { executor.scenarioDataTable = nil }()
+	scenarioResults := make([]*result.ScenarioResult, 0)
+	for executor.scenarioTableRow = 0; executor.scenarioTableRow < executor.scenarioDataTable.GetRowCount(); executor.scenarioTableRow++ {
+		var dataTable parser.Table
+		dataTable.AddHeaders(executor.scenarioDataTable.Headers)
+		dataTable.AddRowValues(executor.scenarioDataTable.Rows()[executor.scenarioTableRow])
+		executor.consoleReporter.DataTable(formatter.FormatTable(&dataTable))
+		scenarioResults = append(scenarioResults, executor.executeScenario(scenario))
 	}
 	return scenarioResults
 }
@@ -366,7 +395,11 @@ func updateProtoStepParameters(protoStep *gauge_messages.ProtoStep, parameters [
 }
 
 func (executor *specExecutor) dataTableLookup() *parser.ArgLookup {
-	return new(parser.ArgLookup).FromDataTableRow(&executor.specification.DataTable.Table, executor.currentTableRow)
+	lookup := new(parser.ArgLookup).FromDataTableRow(&executor.specification.DataTable.Table, executor.currentTableRow)
+	if executor.scenarioDataTable != nil {
+		lookup.AddDataTableRow(executor.scenarioDataTable, executor.scenarioTableRow)
+	}
+	return lookup
 }
 
 func (executor *specExecutor) executeItem(protoItem *gauge_messages.ProtoItem) bool {
@@ -581,6 +614,89 @@ func getDataTableRowsRange(tableRows string, rowCount int) (indexRange, error) {
 	return indexRange{start: startIndex, end: endIndex}, nil
 }
 
+func (r indexRange) rows() []int {
+	rows := make([]int, 0)
+	for i := r.start; i <= r.end; i++ {
+		rows = append(rows, i)
+	}
+	return rows
+}
+
+type tableRowsCondition struct {
+	column   string
+	value    string
+	negative bool
+}
+
+func isTableRowsPredicate(tableRows string) bool {
+	return strings.Contains(tableRows, "=")
+}
+
+// parseTableRowsPredicate reads the column=value and column!=value conditions joined by &&, e.g. region=eu && tier!=free.
+// Each condition is split at its first =, and a range cannot be mixed with conditions.
+func parseTableRowsPredicate(predicate string) ([]tableRowsCondition, error) {
+	conditions := make([]tableRowsCondition, 0)
+	for _, expression := range strings.Split(predicate, "&&") {
+		separator := strings.Index(expression, "=")
+		if separator == -1 {
+			return nil, fmt.Errorf("Invalid table rows predicate '%s', expected column=value or column!=value", strings.TrimSpace(expression))
+		}
+		column, value := expression[:separator], strings.TrimSpace(expression[separator+1:])
+		negative := strings.HasSuffix(column, "!")
+		column = strings.TrimSpace(strings.TrimSuffix(column, "!"))
+		if column == "" || strings.Contains(value, "=") {
+			return nil, fmt.Errorf("Invalid table rows predicate '%s', expected column=value or column!=value", strings.TrimSpace(expression))
+		}
+		conditions = append(conditions, tableRowsCondition{column: column, value: value, negative: negative})
+	}
+	return conditions, nil
+}
+
+func hasColumns(table *parser.Table, conditions []tableRowsCondition) bool {
+	for _, c := range conditions {
+		if !arrayContains(table.Headers, c.column) {
+			return false
+		}
+	}
+	return true
+}
+
+// getDataTableRowsByPredicate returns the rows matching all of the conditions of the predicate.
+func getDataTableRowsByPredicate(predicate string, table *parser.Table) ([]int, error) {
+	conditions, err := parseTableRowsPredicate(predicate)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range conditions {
+		if !arrayContains(table.Headers, c.column) {
+			return nil, fmt.Errorf("Table column %s not found", c.column)
+		}
+	}
+	rows := make([]int, 0)
+	for i := 0; i < table.GetRowCount(); i++ {
+		matches := true
+		for _, c := range conditions {
+			if (table.Get(c.column)[i].Value == c.value) == c.negative {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			rows = append(rows, i)
+		}
+	}
+	return rows, nil
+}
+
+func arrayContains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 func validateTableRowsRange(start string, end string, rowCount int) (int, int, error) {
 	message := "Table rows range validation failed."
 	startRow, err := strconv.Atoi(start)
@@ -22,6 +22,7 @@ import (
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/parser"
 	. "gopkg.in/check.v1"
+	"os"
 )
 
 type specBuilder struct {
@@ -109,7 +110,7 @@ func (s *MySuite) TestResolveConceptToProtoConceptItem(c *C) {
 	conceptDictionary.Add(concepts, "file.cpt")
 	spec, _ := new(parser.SpecParser).Parse(specText, conceptDictionary)
 
-	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil)
+	specExecutor := newSpecExecutor(spec, nil, nil, []int{0}, nil, nil)
 	specExecutor.errMap = &validationErrMaps{make(map[*parser.Specification][]*stepValidationError), make(map[*parser.Scenario][]*stepValidationError), make(map[*parser.Step]*stepValidationError)}
 	protoConcept := specExecutor.resolveToProtoConceptItem(*spec.Scenarios[0].Steps[0]).GetConcept()
 
@@ -150,7 +151,7 @@ func (s *MySuite) TestResolveNestedConceptToProtoConceptItem(c *C) {
 	specParser := new(parser.SpecParser)
 	spec, _ := specParser.Parse(specText, conceptDictionary)
 
-	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil)
+	specExecutor := newSpecExecutor(spec, nil, nil, []int{0}, nil, nil)
 	specExecutor.errMap = &validationErrMaps{make(map[*parser.Specification][]*stepValidationError), make(map[*parser.Scenario][]*stepValidationError), make(map[*parser.Step]*stepValidationError)}
 	protoConcept := specExecutor.resolveToProtoConceptItem(*spec.Scenarios[0].Steps[0]).GetConcept()
 	checkConceptParameterValuesInOrder(c, protoConcept, "456", "foo", "9900")
@@ -205,7 +206,7 @@ func (s *MySuite) TestResolveToProtoConceptItemWithDataTable(c *C) {
 	specParser := new(parser.SpecParser)
 	spec, _ := specParser.Parse(specText, conceptDictionary)
 
-	specExecutor := newSpecExecutor(spec, nil, nil, indexRange{start: 0, end: 0}, nil, nil)
+	specExecutor := newSpecExecutor(spec, nil, nil, []int{0}, nil, nil)
 
 	// For first row
 	specExecutor.currentTableRow = 0
@@ -312,3 +313,104 @@ func (s *MySuite) TestToGetDataTableRowFromInvalidInput(c *C) {
 	_, err = getDataTableRowsRange("", 3)
 	c.Assert(err.Error(), Equals, "Table rows range validation failed.")
 }
+
+func (s *MySuite) TestToGetDataTableRowsByPredicate(c *C) {
+	table := new(parser.Table)
+	table.AddHeaders([]string{"region", "tier"})
+	table.AddRowValues([]string{"eu", "free"})
+	table.AddRowValues([]string{"us", "paid"})
+	table.AddRowValues([]string{"eu", "paid"})
+
+	rows, err := selectDataTableRows("region=eu && tier!=free", table)
+	c.Assert(err, Equals, nil)
+	c.Assert(rows, DeepEquals, []int{2})
+
+	rows, err = selectDataTableRows("region = eu", table)
+	c.Assert(err, Equals, nil)
+	c.Assert(rows, DeepEquals, []int{0, 2})
+
+	rows, err = selectDataTableRows("2-3", table)
+	c.Assert(err, Equals, nil)
+	c.Assert(rows, DeepEquals, []int{1, 2})
+
+	_, err = selectDataTableRows("country=eu", table)
+	c.Assert(err.Error(), Equals, "Table column country not found")
+
+	_, err = selectDataTableRows("region=eu && tier", table)
+	c.Assert(err.Error(), Equals, "Invalid table rows predicate 'tier', expected column=value or column!=value")
+
+	_, err = selectDataTableRows("1-2 && region=eu", table)
+	c.Assert(err.Error(), Equals, "Invalid table rows predicate '1-2', expected column=value or column!=value")
+
+	_, err = selectDataTableRows("tier=free=paid", table)
+	c.Assert(err.Error(), Equals, "Invalid table rows predicate 'tier=free=paid', expected column=value or column!=value")
+}
+
+func (s *MySuite) TestValidateTableRowsFailsForAnInvalidSelection(c *C) {
+	spec := &parser.Specification{FileName: "a.spec"}
+	spec.DataTable.Table.AddHeaders([]string{"region"})
+	spec.DataTable.Table.AddRowValues([]string{"eu"})
+	spec.DataTable.Table.AddRowValues([]string{"us"})
+	c.Assert(validateTableRows([]*parser.Specification{spec}), Equals, nil)
+
+	spec.TableRows = "country=eu"
+	err := validateTableRows([]*parser.Specification{spec})
+	c.Assert(err.Error(), Equals, "Table rows validation failed for a.spec. Table column country not found")
+
+	spec.TableRows = "region=us"
+	rows, err := getDataTableRows(spec)
+	c.Assert(err, Equals, nil)
+	c.Assert(rows, DeepEquals, []int{1})
+}
+
+func (s *MySuite) TestTableRowsPredicateAppliesOnlyToSpecsWithItsColumns(c *C) {
+	TableRows = "region=eu"
+	defer func() { TableRows = "" }()
+	withRegion := &parser.Specification{FileName: "a.spec"}
+	withRegion.DataTable.Table.AddHeaders([]string{"region"})
+	withRegion.DataTable.Table.AddRowValues([]string{"us"})
+	withRegion.DataTable.Table.AddRowValues([]string{"eu"})
+	withoutRegion := &parser.Specification{FileName: "b.spec"}
+	withoutRegion.DataTable.Table.AddHeaders([]string{"id"})
+	withoutRegion.DataTable.Table.AddRowValues([]string{"1"})
+	withoutRegion.DataTable.Table.AddRowValues([]string{"2"})
+
+	c.Assert(validateTableRows([]*parser.Specification{withRegion, withoutRegion}), Equals, nil)
+	rows, _ := getDataTableRows(withRegion)
+	c.Assert(rows, DeepEquals, []int{1})
+	rows, _ = getDataTableRows(withoutRegion)
+	c.Assert(rows, DeepEquals, []int{0, 1})
+
+	TableRows = "region=eu && 1-2"
+	err := validateTableRows([]*parser.Specification{withoutRegion})
+	c.Assert(err.Error(), Equals, "Table rows validation failed for b.spec. Invalid table rows predicate '1-2', expected column=value or column!=value")
+}
+
+func (s *MySuite) TestResolveToProtoStepItemWithScenarioDataTable(c *C) {
+	os.Setenv("allow_scenario_datatable", "true")
+	defer os.Unsetenv("allow_scenario_datatable")
+
+	specText := SpecBuilder().specHeading("A spec heading").
+		tableHeader("id", "name").
+		tableHeader("123", "foo").
+		scenarioHeading("First scenario").
+		tableHeader("name", "phone").
+		tableHeader("bar", "8800").
+		tableHeader("baz", "9900").
+		step("create user <id> <name> and <phone>").
+		String()
+
+	spec, result := new(parser.SpecParser).Parse(specText, new(parser.ConceptDictionary))
+	c.Assert(result.Ok, Equals, true)
+
+	specExecutor := newSpecExecutor(spec, nil, nil, []int{0}, nil, nil)
+	specExecutor.errMap = &validationErrMaps{make(map[*parser.Specification][]*stepValidationError), make(map[*parser.Scenario][]*stepValidationError), make(map[*parser.Step]*stepValidationError)}
+	specExecutor.scenarioDataTable = &spec.Scenarios[0].DataTable.Table
+
+	specExecutor.scenarioTableRow = 1
+	params := getParameters(specExecutor.resolveToProtoStepItem(spec.Scenarios[0].Steps[0]).GetStep().GetFragments())
+	c.Assert(len(params), Equals, 3)
+	c.Assert(params[0].GetValue(), Equals, "123")
+	c.Assert(params[1].GetValue(), Equals, "baz")
+	c.Assert(params[2].GetValue(), Equals, "9900")
+}
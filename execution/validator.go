@@ -97,11 +97,19 @@ func (self *specValidator) Step(step *parser.Step) {
 			self.stepValidationCache[step.Value] = err
 		} else if value != nil {
 			self.stepValidationErrors = append(self.stepValidationErrors,
-				&stepValidationError{step: step, fileName: self.specification.FileName, errorType: value.errorType, message: value.message})
+				&stepValidationError{step: step, fileName: self.fileNameOf(step), errorType: value.errorType, message: value.message})
 		}
 	}
 }
 
+// fileNameOf returns the file a step was read from, which is a context file for included steps
+func (self *specValidator) fileNameOf(step *parser.Step) string {
+	if step.FileName != "" {
+		return step.FileName
+	}
+	return self.specification.FileName
+}
+
 var invalidResponse gauge_messages.StepValidateResponse_ErrorType = -1
 
 func (self *specValidator) validateStep(step *parser.Step) *stepValidationError {
@@ -109,17 +117,17 @@ func (self *specValidator) validateStep(step *parser.Step) *stepValidationError
 		StepValidateRequest: &gauge_messages.StepValidateRequest{StepText: proto.String(step.Value), NumberOfParameters: proto.Int(len(step.Args))}}
 	response, err := conn.GetResponseForMessageWithTimeout(message, self.runner.Connection, config.RunnerRequestTimeout())
 	if err != nil {
-		return &stepValidationError{step: step, message: err.Error(), fileName: self.specification.FileName}
+		return &stepValidationError{step: step, message: err.Error(), fileName: self.fileNameOf(step)}
 	}
 	if response.GetMessageType() == gauge_messages.Message_StepValidateResponse {
 		validateResponse := response.GetStepValidateResponse()
 		if !validateResponse.GetIsValid() {
 			message := getMessage(validateResponse.ErrorType.String())
-			return &stepValidationError{step: step, fileName: self.specification.FileName, errorType: validateResponse.ErrorType, message: message}
+			return &stepValidationError{step: step, fileName: self.fileNameOf(step), errorType: validateResponse.ErrorType, message: message}
 		}
 		return nil
 	} else {
-		return &stepValidationError{step: step, fileName: self.specification.FileName, errorType: &invalidResponse, message: "Invalid response from runner for Validation request"}
+		return &stepValidationError{step: step, fileName: self.fileNameOf(step), errorType: &invalidResponse, message: "Invalid response from runner for Validation request"}
 	}
 }
 
@@ -135,6 +143,18 @@ func (self *specValidator) ContextStep(step *parser.Step) {
 func (self *specValidator) TearDown(step *parser.TearDown) {
 }
 
+func (self *specValidator) Include(include *parser.Include) {
+	for _, step := range include.ContextSteps {
+		self.Step(step)
+	}
+	for _, step := range include.TearDownSteps {
+		self.Step(step)
+	}
+}
+
+func (self *specValidator) FrontMatter(frontMatter *parser.FrontMatter) {
+}
+
 func (self *specValidator) SpecHeading(heading *parser.Heading) {
 	self.stepValidationErrors = make([]*stepValidationError, 0)
 }
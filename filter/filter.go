@@ -5,6 +5,7 @@ import (
 )
 
 var ExecuteTags string
+var MetadataExpression string
 var DoNotRandomize bool
 var Distribute int
 var NumberOfExecutionStreams int
@@ -17,7 +18,7 @@ func GetSpecsToExecute(conceptsDictionary *parser.ConceptDictionary, args []stri
 }
 
 func specsFilters() []specsFilter {
-	return []specsFilter{&tagsFilter{ExecuteTags}, &specsGroupFilter{Distribute, NumberOfExecutionStreams}, &specRandomizer{DoNotRandomize}}
+	return []specsFilter{&tagsFilter{ExecuteTags}, &metadataFilter{MetadataExpression}, &specsGroupFilter{Distribute, NumberOfExecutionStreams}, &specRandomizer{DoNotRandomize}}
 }
 
 func applyFilters(specsToExecute []*parser.Specification, filters []specsFilter) []*parser.Specification {
@@ -33,7 +34,12 @@ func specsFromArgs(conceptDictionary *parser.ConceptDictionary, args []string) [
 	var specParseResults []*parser.ParseResult
 	for _, arg := range args {
 		specSource := arg
-		if isIndexedSpec(specSource) {
+		if specName, tableRows, ok := getSpecWithTableRows(specSource); ok {
+			specs, specParseResults = parser.FindSpecs(specName, conceptDictionary)
+			for _, spec := range specs {
+				spec.TableRows = tableRows
+			}
+		} else if isIndexedSpec(specSource) {
 			specs, specParseResults = getSpecWithScenarioIndex(specSource, conceptDictionary)
 		} else {
 			specs, specParseResults = parser.FindSpecs(specSource, conceptDictionary)
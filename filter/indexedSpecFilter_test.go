@@ -18,6 +18,11 @@
 package filter
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/parser"
 	. "gopkg.in/check.v1"
 )
 
@@ -43,3 +48,33 @@ func (s *MySuite) TestToObtainIndexedSpecName1(c *C) {
 	c.Assert(specName, Equals, "hello_world.spec")
 	c.Assert(scenarioNum, Equals, 67342)
 }
+
+func (s *MySuite) TestGetSpecWithTableRows(c *C) {
+	specName, tableRows, ok := getSpecWithTableRows("specs/a.spec:rows=1-3")
+	c.Assert(ok, Equals, true)
+	c.Assert(specName, Equals, "specs/a.spec")
+	c.Assert(tableRows, Equals, "1-3")
+
+	specName, tableRows, ok = getSpecWithTableRows("specs/a.spec:rows=region=eu && tier!=free")
+	c.Assert(ok, Equals, true)
+	c.Assert(specName, Equals, "specs/a.spec")
+	c.Assert(tableRows, Equals, "region=eu && tier!=free")
+
+	_, _, ok = getSpecWithTableRows("specs/a.spec:3")
+	c.Assert(ok, Equals, false)
+}
+
+func (s *MySuite) TestSpecsFromArgsKeepsTableRowsOnTheSpec(c *C) {
+	dir, _ := ioutil.TempDir("", "tableRows")
+	defer os.RemoveAll(dir)
+	specFile := filepath.Join(dir, "a.spec")
+	ioutil.WriteFile(specFile, []byte("Spec heading\n============\n     |id|\n     |--|\n     |1 |\n     |2 |\nScenario\n--------\n* say <id>\n"), 0644)
+	otherSpecFile := filepath.Join(dir, "b.spec")
+	ioutil.WriteFile(otherSpecFile, []byte("Other spec\n==========\nScenario\n--------\n* say hello\n"), 0644)
+
+	specs := specsFromArgs(new(parser.ConceptDictionary), []string{specFile + ":rows=2", otherSpecFile})
+
+	c.Assert(len(specs), Equals, 2)
+	c.Assert(specs[0].TableRows, Equals, "2")
+	c.Assert(specs[1].TableRows, Equals, "")
+}
@@ -0,0 +1,72 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package filter
+
+import (
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+)
+
+// metadataFilter retains the specs whose front-matter satisfies the expression. The expression has the
+// syntax of a tag expression, with operands like component=checkout, jira!=PAY-* or priority<=2.
+// An operand which is only a key retains the specs defining that key.
+type metadataFilter struct {
+	expression string
+}
+
+func (metadataFilter *metadataFilter) filter(specs []*parser.Specification) []*parser.Specification {
+	if metadataFilter.expression == "" {
+		return specs
+	}
+	compiled, err := compileTagExpression(metadataFilter.expression)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	return filterSpecsByMetadata(specs, compiled)
+}
+
+func filterSpecsByMetadata(specs []*parser.Specification, compiled tagExpression) []*parser.Specification {
+	filteredSpecs := make([]*parser.Specification, 0)
+	for _, spec := range specs {
+		frontMatter := spec.FrontMatter
+		isMatching := compiled.evaluate(func(operand string) bool {
+			return isMetadataMatching(frontMatter, operand)
+		})
+		if isMatching {
+			filteredSpecs = append(filteredSpecs, spec)
+		}
+	}
+	return filteredSpecs
+}
+
+// isMetadataMatching checks whether any value of the key satisfies the comparison. Values with spaces
+// are matched by quoting them in the expression, like owner='payments team'.
+func isMetadataMatching(frontMatter *parser.FrontMatter, operand string) bool {
+	match := tagComparison.FindStringSubmatch(operand)
+	if match == nil {
+		_, ok := frontMatter.Get(operand)
+		return ok
+	}
+	values, _ := frontMatter.Get(match[1])
+	for _, value := range values {
+		if isValueMatching(value, match[2], match[3]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package filter
+
+import (
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestFilterSpecsByMetadata(c *C) {
+	checkout := &parser.Specification{FileName: "checkout.spec", FrontMatter: &parser.FrontMatter{Metadata: []*parser.Metadata{
+		&parser.Metadata{Key: "component", Values: []string{"checkout"}},
+		&parser.Metadata{Key: "jira", Values: []string{"PAY-12", "PAY-13"}},
+		&parser.Metadata{Key: "owner", Values: []string{"payments team"}},
+	}}}
+	search := &parser.Specification{FileName: "search.spec", FrontMatter: &parser.FrontMatter{Metadata: []*parser.Metadata{
+		&parser.Metadata{Key: "component", Values: []string{"search"}},
+		&parser.Metadata{Key: "priority", Values: []string{"2"}},
+	}}}
+	withoutFrontMatter := &parser.Specification{FileName: "login.spec"}
+	specs := []*parser.Specification{checkout, search, withoutFrontMatter}
+
+	c.Assert(fileNames((&metadataFilter{"component=checkout"}).filter(specs)), DeepEquals, []string{"checkout.spec"})
+	c.Assert(fileNames((&metadataFilter{"Component=check*"}).filter(specs)), DeepEquals, []string{"checkout.spec"})
+	c.Assert(fileNames((&metadataFilter{"jira=PAY-13 & owner='payments team'"}).filter(specs)), DeepEquals, []string{"checkout.spec"})
+	c.Assert(fileNames((&metadataFilter{"priority<=2 | !component"}).filter(specs)), DeepEquals, []string{"search.spec", "login.spec"})
+	c.Assert(fileNames((&metadataFilter{"component!=search"}).filter(specs)), DeepEquals, []string{"checkout.spec"})
+	c.Assert(len((&metadataFilter{""}).filter(specs)), Equals, 3)
+}
+
+func (s *MySuite) TestFilterSpecsByMetadataKeepsSpacesInQuotedValues(c *C) {
+	spaced := &parser.Specification{FileName: "spaced.spec", FrontMatter: &parser.FrontMatter{Metadata: []*parser.Metadata{
+		&parser.Metadata{Key: "owner", Values: []string{"a b"}},
+	}}}
+	joined := &parser.Specification{FileName: "joined.spec", FrontMatter: &parser.FrontMatter{Metadata: []*parser.Metadata{
+		&parser.Metadata{Key: "owner", Values: []string{"ab"}},
+	}}}
+	specs := []*parser.Specification{spaced, joined}
+
+	c.Assert(fileNames((&metadataFilter{"owner='a b'"}).filter(specs)), DeepEquals, []string{"spaced.spec"})
+	c.Assert(fileNames((&metadataFilter{"owner = ab"}).filter(specs)), DeepEquals, []string{"joined.spec"})
+}
+
+func fileNames(specs []*parser.Specification) []string {
+	names := make([]string, 0)
+	for _, spec := range specs {
+		names = append(names, spec.FileName)
+	}
+	return names
+}
@@ -18,26 +18,27 @@
 package filter
 
 import (
-	"errors"
+	"path"
 	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
-	"golang.org/x/tools/go/exact"
-	"golang.org/x/tools/go/types"
 )
 
 var currentTagExp string
 
+// tagComparison matches the operands of a tag expression which compare the value of key:value tags, like priority<=2
+var tagComparison = regexp.MustCompile("^([^<>=!]+)(<=|>=|!=|=|<|>)(.+)$")
+
 type scenarioIndexFilterToRetain struct {
 	indexToNotFilter     int
 	currentScenarioIndex int
 }
 type ScenarioFilterBasedOnTags struct {
-	specTags      []string
-	tagExpression string
+	specTags []string
+	compiled tagExpression
 }
 
 func newScenarioIndexFilterToRetain(index int) *scenarioIndexFilterToRetain {
@@ -57,8 +58,8 @@ func (filter *scenarioIndexFilterToRetain) Filter(item parser.Item) bool {
 	return false
 }
 
-func newScenarioFilterBasedOnTags(specTags []string, tagExp string) *ScenarioFilterBasedOnTags {
-	return &ScenarioFilterBasedOnTags{specTags, tagExp}
+func newScenarioFilterBasedOnTags(specTags []string, compiled tagExpression) *ScenarioFilterBasedOnTags {
+	return &ScenarioFilterBasedOnTags{specTags, compiled}
 }
 
 func (filter *ScenarioFilterBasedOnTags) Filter(item parser.Item) bool {
@@ -72,101 +73,83 @@ func (filter *ScenarioFilterBasedOnTags) Filter(item parser.Item) bool {
 	return false
 }
 
+// filterTags tells whether tags satisfy the expression. Tags are compared as written, so a tag with spaces is
+// matched by quoting it in the expression, like 'smoke test'.
 func (filter *ScenarioFilterBasedOnTags) filterTags(stags []string) bool {
 	tagsMap := make(map[string]bool, 0)
 	for _, tag := range stags {
-		tagsMap[strings.Replace(tag, " ", "", -1)] = true
-	}
-	filter.replaceSpecialChar()
-	value, _ := filter.formatAndEvaluateExpression(tagsMap, filter.isTagPresent)
-	return value
-}
-func (filter *ScenarioFilterBasedOnTags) replaceSpecialChar() {
-	filter.tagExpression = strings.Replace(strings.Replace(strings.Replace(strings.Replace(filter.tagExpression, " ", "", -1), ",", "&", -1), "&&", "&", -1), "||", "|", -1)
-}
-
-func (filter *ScenarioFilterBasedOnTags) formatAndEvaluateExpression(tagsMap map[string]bool, isTagQualified func(tagsMap map[string]bool, tagName string) bool) (bool, error) {
-	_, tags := filter.getOperatorsAndOperands()
-	expToBeEvaluated := filter.tagExpression
-	for _, tag := range tags {
-		expToBeEvaluated = strings.Replace(expToBeEvaluated, strings.TrimSpace(tag), strconv.FormatBool(isTagQualified(tagsMap, strings.TrimSpace(tag))), -1)
+		tagsMap[strings.TrimSpace(tag)] = true
 	}
-	return filter.evaluateExp(filter.handleNegation(expToBeEvaluated))
+	return filter.compiled.evaluate(func(tagName string) bool {
+		return filter.isTagPresent(tagsMap, tagName)
+	})
 }
 
-func (filter *ScenarioFilterBasedOnTags) handleNegation(tagExpression string) string {
-	tagExpression = strings.Replace(strings.Replace(tagExpression, "!true", "false", -1), "!false", "true", -1)
-	for strings.Contains(tagExpression, "!(") {
-		tagExpression = filter.evaluateBrackets(tagExpression)
+func (filter *ScenarioFilterBasedOnTags) isTagPresent(tagsMap map[string]bool, tagName string) bool {
+	if match := tagComparison.FindStringSubmatch(tagName); match != nil {
+		return filter.isTagValueMatching(tagsMap, match[1], match[2], match[3])
 	}
-	return tagExpression
-}
-
-func (filter *ScenarioFilterBasedOnTags) evaluateBrackets(tagExpression string) string {
-	if strings.Contains(tagExpression, "!(") {
-		innerText := filter.resolveBracketExpression(tagExpression)
-		return strings.Replace(tagExpression, "!("+innerText+")", filter.evaluateBrackets(innerText), -1)
+	if strings.Contains(tagName, "*") {
+		for tag := range tagsMap {
+			if matched, _ := path.Match(tagName, tag); matched {
+				return true
+			}
+		}
+		return false
 	}
-	value, _ := filter.evaluateExp(tagExpression)
-	return strconv.FormatBool(!value)
+	_, ok := tagsMap[tagName]
+	return ok
 }
 
-func (filter *ScenarioFilterBasedOnTags) resolveBracketExpression(tagExpression string) string {
-	indexOfOpenBracket := strings.Index(tagExpression, "!(") + 1
-	bracketStack := make([]string, 0)
-	i := indexOfOpenBracket
-	for ; i < len(tagExpression); i++ {
-		if tagExpression[i] == '(' {
-			bracketStack = append(bracketStack, "(")
-		} else if tagExpression[i] == ')' {
-			bracketStack = append(bracketStack[:len(bracketStack)-1])
+// isTagValueMatching checks whether any key:value tag with the given key has a value satisfying the comparison.
+// Values are compared as numbers when both are numbers, and = and != accept wildcards in the value.
+func (filter *ScenarioFilterBasedOnTags) isTagValueMatching(tagsMap map[string]bool, key string, operator string, expected string) bool {
+	for tag := range tagsMap {
+		tagKey, value, ok := parser.SplitKeyValueTag(tag)
+		if !ok {
+			continue
 		}
-		if len(bracketStack) == 0 {
-			break
+		if matched, _ := path.Match(key, tagKey); !matched {
+			continue
+		}
+		if isValueMatching(value, operator, expected) {
+			return true
 		}
 	}
-	return tagExpression[indexOfOpenBracket+1 : i]
+	return false
 }
 
-func (filter *ScenarioFilterBasedOnTags) evaluateExp(tagExpression string) (bool, error) {
-	tre := regexp.MustCompile("true")
-	fre := regexp.MustCompile("false")
-
-	s := fre.ReplaceAllString(tre.ReplaceAllString(tagExpression, "1"), "0")
-
-	val, err := types.Eval(s, nil, nil)
-	if err != nil {
-		return false, errors.New("Invalid Expression.\n" + err.Error())
-	}
-	res, _ := exact.Uint64Val(val.Value)
-
-	var final bool
-	if res == 1 {
-		final = true
-	} else {
-		final = false
+func isValueMatching(value string, operator string, expected string) bool {
+	if operator == "=" || operator == "!=" {
+		matched, _ := path.Match(expected, value)
+		return matched == (operator == "=")
 	}
-
-	return final, nil
-}
-
-func (filter *ScenarioFilterBasedOnTags) isTagPresent(tagsMap map[string]bool, tagName string) bool {
-	_, ok := tagsMap[tagName]
-	return ok
-}
-
-func (filter *ScenarioFilterBasedOnTags) getOperatorsAndOperands() ([]string, []string) {
-	listOfOperators := make([]string, 0)
-	listOfTags := strings.FieldsFunc(filter.tagExpression, func(r rune) bool {
-		isValidOperator := r == '&' || r == '|' || r == '(' || r == ')' || r == '!'
-		if isValidOperator {
-			operator, _ := strconv.Unquote(strconv.QuoteRuneToASCII(r))
-			listOfOperators = append(listOfOperators, operator)
-			return isValidOperator
+	return compareTagValues(value, expected, operator)
+}
+
+func compareTagValues(value string, expected string, operator string) bool {
+	comparison := strings.Compare(value, expected)
+	number, err := strconv.ParseFloat(value, 64)
+	expectedNumber, expectedErr := strconv.ParseFloat(expected, 64)
+	if err == nil && expectedErr == nil {
+		comparison = 0
+		if number < expectedNumber {
+			comparison = -1
+		} else if number > expectedNumber {
+			comparison = 1
 		}
-		return false
-	})
-	return listOfOperators, listOfTags
+	}
+	switch operator {
+	case "<":
+		return comparison < 0
+	case "<=":
+		return comparison <= 0
+	case ">":
+		return comparison > 0
+	case ">=":
+		return comparison >= 0
+	}
+	return false
 }
 
 func filterSpecsItems(specs []*parser.Specification, filter parser.SpecItemFilter) []*parser.Specification {
@@ -180,14 +163,14 @@ func filterSpecsItems(specs []*parser.Specification, filter parser.SpecItemFilte
 	return filteredSpecs
 }
 
-func filterSpecsByTags(specs []*parser.Specification, tagExpression string) []*parser.Specification {
+func filterSpecsByTags(specs []*parser.Specification, compiled tagExpression) []*parser.Specification {
 	filteredSpecs := make([]*parser.Specification, 0)
 	for _, spec := range specs {
 		tagValues := make([]string, 0)
 		if spec.Tags != nil {
 			tagValues = spec.Tags.Values
 		}
-		spec.Filter(newScenarioFilterBasedOnTags(tagValues, tagExpression))
+		spec.Filter(newScenarioFilterBasedOnTags(tagValues, compiled))
 		if len(spec.Scenarios) != 0 {
 			filteredSpecs = append(filteredSpecs, spec)
 		}
@@ -195,11 +178,32 @@ func filterSpecsByTags(specs []*parser.Specification, tagExpression string) []*p
 	return filteredSpecs
 }
 
-func validateTagExpression(tagExpression string) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: tagExpression}
-	filter.replaceSpecialChar()
-	_, err := filter.formatAndEvaluateExpression(make(map[string]bool, 0), func(a map[string]bool, b string) bool { return true })
+// TagsUsedBy returns the tags which the tag expression refers to, by name, wildcard or key:value comparison.
+func TagsUsedBy(tagExpression string, tags []string) ([]string, error) {
+	compiled, err := compileTagExpression(tagExpression)
+	if err != nil {
+		return nil, err
+	}
+	operands := tagOperands(compiled)
+	filter := &ScenarioFilterBasedOnTags{}
+	used := make([]string, 0)
+	for _, tag := range tags {
+		tagsMap := map[string]bool{strings.TrimSpace(tag): true}
+		for _, operand := range operands {
+			if filter.isTagPresent(tagsMap, operand) {
+				used = append(used, tag)
+				break
+			}
+		}
+	}
+	return used, nil
+}
+
+// validateTagExpression compiles the tag expression once for the run, failing the run on a syntax error.
+func validateTagExpression(expression string) tagExpression {
+	compiled, err := compileTagExpression(expression)
 	if err != nil {
 		logger.Fatal(err.Error())
 	}
+	return compiled
 }
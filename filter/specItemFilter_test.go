@@ -30,6 +30,16 @@ type MySuite struct{}
 
 var _ = Suite(&MySuite{})
 
+func compiledTagExpression(c *C, expression string) tagExpression {
+	compiled, err := compileTagExpression(expression)
+	c.Assert(err, IsNil)
+	return compiled
+}
+
+func scenarioFilterFor(c *C, expression string) *ScenarioFilterBasedOnTags {
+	return newScenarioFilterBasedOnTags(nil, compiledTagExpression(c, expression))
+}
+
 type specBuilder struct {
 	lines []string
 }
@@ -184,66 +194,66 @@ func (s *MySuite) TestScenarioIndexFilterWithWrongScenarioIndex(c *C) {
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionWithTwoTags(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag1 & tag3"}
+	filter := scenarioFilterFor(c, "tag1 & tag3")
 	c.Assert(filter.filterTags([]string{"tag1", "tag2"}), Equals, false)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionWithComplexTagExpression(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag1 & ((tag3 | tag2) & (tag5 | tag4 | tag3) & tag7) | tag6"}
+	filter := scenarioFilterFor(c, "tag1 & ((tag3 | tag2) & (tag5 | tag4 | tag3) & tag7) | tag6")
 	c.Assert(filter.filterTags([]string{"tag1", "tag2", "tag7", "tag4"}), Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionWithFailingTagExpression(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag1 & ((tag3 | tag2) & (tag5 | tag4 | tag3) & tag7) & tag6"}
+	filter := scenarioFilterFor(c, "tag1 & ((tag3 | tag2) & (tag5 | tag4 | tag3) & tag7) & tag6")
 	c.Assert(filter.filterTags([]string{"tag1", "tag2", "tag7", "tag4"}), Equals, false)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionWithWrongTagExpression(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag1 & ((((tag3 | tag2) & (tag5 | tag4 | tag3) & tag7) & tag6"}
-	c.Assert(filter.filterTags([]string{"tag1", "tag2", "tag7", "tag4"}), Equals, false)
+	_, err := compileTagExpression("tag1 & ((((tag3 | tag2) & (tag5 | tag4 | tag3) & tag7) & tag6")
+	c.Assert(err, NotNil)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingOfSpaces(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag 1 & tag3"}
+	filter := scenarioFilterFor(c, "'tag 1' & tag3")
 	c.Assert(filter.filterTags([]string{"tag 1", "tag3"}), Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingLogicalNotOperator(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "!tag 1 & tag3"}
+	filter := scenarioFilterFor(c, "!'tag 1' & tag3")
 	c.Assert(filter.filterTags([]string{"tag2", "tag3"}), Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingManyLogicalNotOperator(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "!(!(tag 1 | !(tag6 | !(tag5))) & tag2)"}
-	value := filter.filterTags([]string{"tag2", "tag4"})
-	c.Assert(value, Equals, true)
+	filter := scenarioFilterFor(c, "!(!(tag1 | !(tag6 | !(tag5))) & tag2)")
+	c.Assert(filter.filterTags([]string{"tag2", "tag4"}), Equals, false)
+	c.Assert(filter.filterTags([]string{"tag1", "tag2"}), Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingParallelLogicalNotOperator(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "!(tag1) & ! (tag3 & ! (tag3))"}
+	filter := scenarioFilterFor(c, "!(tag1) & ! (tag3 & ! (tag3))")
 	value := filter.filterTags([]string{"tag2", "tag4"})
 	c.Assert(value, Equals, true)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingComma(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag 1 , tag3"}
+	filter := scenarioFilterFor(c, "tag1 , tag3")
 	c.Assert(filter.filterTags([]string{"tag2", "tag3"}), Equals, false)
 }
 
 func (s *MySuite) TestToEvaluateTagExpressionConsistingCommaGivesTrue(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "tag 1 , tag3"}
+	filter := scenarioFilterFor(c, "tag1 , tag3")
 	c.Assert(filter.filterTags([]string{"tag1", "tag3"}), Equals, true)
 }
 func (s *MySuite) TestToEvaluateTagExpressionConsistingTrueAndFalseAsTagNames(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "true , false"}
+	filter := scenarioFilterFor(c, "true , false")
 	c.Assert(filter.filterTags([]string{"true", "false"}), Equals, true)
 }
 func (s *MySuite) TestToEvaluateTagExpressionConsistingTrueAndFalseAsTagSDFNames(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "!true"}
+	filter := scenarioFilterFor(c, "!true")
 	c.Assert(filter.filterTags(nil), Equals, true)
 }
 func (s *MySuite) TestToEvaluateTagExpressionConsistingSpecialCharacters(c *C) {
-	filter := &ScenarioFilterBasedOnTags{tagExpression: "a && b || c | b & b"}
+	filter := scenarioFilterFor(c, "a && b || c | b & b")
 	c.Assert(filter.filterTags([]string{"a", "b"}), Equals, true)
 }
 
@@ -272,7 +282,7 @@ func (s *MySuite) TestToFilterSpecsByTagExpOfTwoTags(c *C) {
 
 	c.Assert(specs[0].Tags.Values[0], Equals, myTags[0])
 	c.Assert(specs[0].Tags.Values[1], Equals, myTags[1])
-	specs = filterSpecsByTags(specs, "tag1 & tag2")
+	specs = filterSpecsByTags(specs, compiledTagExpression(c, "tag1 & tag2"))
 	c.Assert(len(specs), Equals, 1)
 }
 
@@ -301,7 +311,7 @@ func (s *MySuite) TestToEvaluateTagExpression(c *C) {
 	specs = append(specs, spec1)
 	specs = append(specs, spec2)
 
-	specs = filterSpecsByTags(specs, "tag1 & !(tag1 & tag4) & (tag2 | tag3)")
+	specs = filterSpecsByTags(specs, compiledTagExpression(c, "tag1 & !(tag1 & tag4) & (tag2 | tag3)"))
 	c.Assert(len(specs), Equals, 1)
 	c.Assert(len(specs[0].Scenarios), Equals, 2)
 	c.Assert(specs[0].Scenarios[0].Heading.Value, Equals, "Scenario Heading 1")
@@ -333,8 +343,8 @@ func (s *MySuite) TestToFilterSpecsByWrongTagExpression(c *C) {
 
 	c.Assert(specs[0].Tags.Values[0], Equals, myTags[0])
 	c.Assert(specs[0].Tags.Values[1], Equals, myTags[1])
-	specs = filterSpecsByTags(specs, "(tag1 & tag2")
-	c.Assert(len(specs), Equals, 0)
+	_, err := compileTagExpression("(tag1 & tag2")
+	c.Assert(err, NotNil)
 }
 
 func (s *MySuite) TestToFilterMultipleScenariosByMultipleTags(c *C) {
@@ -362,7 +372,7 @@ func (s *MySuite) TestToFilterMultipleScenariosByMultipleTags(c *C) {
 	c.Assert(len(specs[0].Scenarios[2].Tags.Values), Equals, 2)
 	c.Assert(len(specs[0].Scenarios[3].Tags.Values), Equals, 4)
 
-	specs = filterSpecsByTags(specs, "tag1 & tag2")
+	specs = filterSpecsByTags(specs, compiledTagExpression(c, "tag1 & tag2"))
 	c.Assert(len(specs[0].Scenarios), Equals, 3)
 	c.Assert(specs[0].Scenarios[0].Heading.Value, Equals, "Scenario Heading 2")
 	c.Assert(specs[0].Scenarios[1].Heading.Value, Equals, "Scenario Heading 3")
@@ -386,7 +396,7 @@ func (s *MySuite) TestToFilterScenariosByTagsAtSpecLevel(c *C) {
 
 	c.Assert(len(specs[0].Scenarios), Equals, 3)
 	c.Assert(len(specs[0].Tags.Values), Equals, 2)
-	specs = filterSpecsByTags(specs, "tag1 & tag2")
+	specs = filterSpecsByTags(specs, compiledTagExpression(c, "tag1 & tag2"))
 	c.Assert(len(specs[0].Scenarios), Equals, 3)
 	c.Assert(specs[0].Scenarios[0].Heading.Value, Equals, "Scenario Heading 1")
 	c.Assert(specs[0].Scenarios[1].Heading.Value, Equals, "Scenario Heading 2")
@@ -429,7 +439,7 @@ func (s *MySuite) TestToFilterSpecsByTags(c *C) {
 	specs = append(specs, spec1)
 	specs = append(specs, spec2)
 	specs = append(specs, spec3)
-	specs = filterSpecsByTags(specs, "tag1 & tag2")
+	specs = filterSpecsByTags(specs, compiledTagExpression(c, "tag1 & tag2"))
 	c.Assert(len(specs), Equals, 2)
 	c.Assert(len(specs[0].Scenarios), Equals, 1)
 	c.Assert(len(specs[1].Scenarios), Equals, 1)
@@ -454,7 +464,7 @@ func (s *MySuite) TestToFilterScenariosByTag(c *C) {
 
 	var specs []*parser.Specification
 	specs = append(specs, spec)
-	specs = filterSpecsByTags(specs, "tag1 & tag2")
+	specs = filterSpecsByTags(specs, compiledTagExpression(c, "tag1 & tag2"))
 	c.Assert(len(specs[0].Scenarios), Equals, 1)
 	c.Assert(specs[0].Scenarios[0].Heading.Value, Equals, "Scenario Heading 2")
 }
@@ -478,7 +488,7 @@ func (s *MySuite) TestToFilterMultipleScenariosByTags(c *C) {
 	c.Assert(len(specs[0].Scenarios), Equals, 3)
 	c.Assert(len(specs[0].Scenarios[0].Tags.Values), Equals, 1)
 	c.Assert(len(specs[0].Scenarios[1].Tags.Values), Equals, 2)
-	specs = filterSpecsByTags(specs, "tag1 & tag2")
+	specs = filterSpecsByTags(specs, compiledTagExpression(c, "tag1 & tag2"))
 	c.Assert(len(specs[0].Scenarios), Equals, 2)
 	c.Assert(specs[0].Scenarios[0].Heading.Value, Equals, "Scenario Heading 2")
 	c.Assert(specs[0].Scenarios[1].Heading.Value, Equals, "Scenario Heading 3")
@@ -501,7 +511,7 @@ func (s *MySuite) TestToFilterScenariosByUnavailableTags(c *C) {
 
 	var specs []*parser.Specification
 	specs = append(specs, spec)
-	specs = filterSpecsByTags(specs, "tag3")
+	specs = filterSpecsByTags(specs, compiledTagExpression(c, "tag3"))
 	c.Assert(len(specs), Equals, 0)
 }
 
@@ -552,3 +562,92 @@ func (s *MySuite) TestToSortSpecs(c *C) {
 	c.Assert(specs[1].FileName, Equals, spec2.FileName)
 	c.Assert(specs[2].FileName, Equals, spec3.FileName)
 }
+
+func (s *MySuite) TestToEvaluateTagExpressionWithKeyValueTags(c *C) {
+	tags := []string{"owner:payments", "priority: 1", "smoke-login"}
+
+	c.Assert(scenarioFilterFor(c, "owner=payments").filterTags(tags), Equals, true)
+	c.Assert(scenarioFilterFor(c, "owner=pay*").filterTags(tags), Equals, true)
+	c.Assert(scenarioFilterFor(c, "owner=search").filterTags(tags), Equals, false)
+	c.Assert(scenarioFilterFor(c, "owner:payments").filterTags(tags), Equals, true)
+	c.Assert(scenarioFilterFor(c, "priority<=2 & owner=payments").filterTags(tags), Equals, true)
+	c.Assert(scenarioFilterFor(c, "priority>1").filterTags(tags), Equals, false)
+	c.Assert(scenarioFilterFor(c, "!(priority<1)").filterTags(tags), Equals, true)
+}
+
+func (s *MySuite) TestToEvaluateTagExpressionWithWildcards(c *C) {
+	tags := []string{"smoke-login", "regression"}
+
+	c.Assert(scenarioFilterFor(c, "smoke-*").filterTags(tags), Equals, true)
+	c.Assert(scenarioFilterFor(c, "smoke-* & !regr*").filterTags(tags), Equals, false)
+	c.Assert(scenarioFilterFor(c, "sanity-*").filterTags(tags), Equals, false)
+}
+
+func (s *MySuite) TestCompareTagValues(c *C) {
+	c.Assert(compareTagValues("10", "9", ">"), Equals, true)
+	c.Assert(compareTagValues("b", "a", ">="), Equals, true)
+	c.Assert(compareTagValues("1.5", "2", "<"), Equals, true)
+}
+
+func (s *MySuite) TestToEvaluateTagExpressionWithTagsSharingPrefix(c *C) {
+	filter := scenarioFilterFor(c, "tag & !tag10")
+	c.Assert(filter.filterTags([]string{"tag", "tag1"}), Equals, true)
+	c.Assert(filter.filterTags([]string{"tag", "tag10"}), Equals, false)
+}
+
+func (s *MySuite) TestToEvaluateTagExpressionWithSpecialCharactersInTagNames(c *C) {
+	tags := []string{"v1.2", "smoke-test", "a&b", "owner:pay"}
+
+	c.Assert(scenarioFilterFor(c, "v1.2 & smoke-test").filterTags(tags), Equals, true)
+	c.Assert(scenarioFilterFor(c, "'a&b' & !\"a|b\"").filterTags(tags), Equals, true)
+	c.Assert(scenarioFilterFor(c, "owner!=search").filterTags(tags), Equals, true)
+	c.Assert(scenarioFilterFor(c, "owner!=pay").filterTags(tags), Equals, false)
+}
+
+func (s *MySuite) TestToEvaluateTagExpressionWithQuotedValues(c *C) {
+	tags := []string{"owner:a&b team", "smoke"}
+
+	c.Assert(scenarioFilterFor(c, "owner='a&b team' & smoke").filterTags(tags), Equals, true)
+	c.Assert(scenarioFilterFor(c, "owner!=\"a|b\" & smoke").filterTags(tags), Equals, true)
+
+	_, err := compileTagExpression("owner='a&b")
+	c.Assert(err.Error(), Equals, "Invalid tag expression at position 7: unterminated quoted value\nowner='a&b\n      ^")
+}
+
+func (s *MySuite) TestToEvaluateTagExpressionWithSpacesAroundComparisons(c *C) {
+	tags := []string{"owner:payments team", "priority:2"}
+
+	c.Assert(scenarioFilterFor(c, "priority <= 2 & owner = 'payments team'").filterTags(tags), Equals, true)
+	c.Assert(scenarioFilterFor(c, "owner != 'payments team'").filterTags(tags), Equals, false)
+}
+
+func (s *MySuite) TestToEvaluateQuotedTagsAsWritten(c *C) {
+	c.Assert(scenarioFilterFor(c, "'smoke test'").filterTags([]string{"smoke test"}), Equals, true)
+	c.Assert(scenarioFilterFor(c, "'smoke test'").filterTags([]string{"smoketest"}), Equals, false)
+}
+
+func (s *MySuite) TestToEvaluateTagExpressionWithOperatorPrecedence(c *C) {
+	filter := scenarioFilterFor(c, "tag1 | tag2 & tag3")
+	c.Assert(filter.filterTags([]string{"tag1"}), Equals, true)
+	c.Assert(filter.filterTags([]string{"tag2"}), Equals, false)
+}
+
+func (s *MySuite) TestCompileTagExpressionReportsPositionOfSyntaxError(c *C) {
+	_, err := compileTagExpression("tag1 & (tag2 | tag3")
+	c.Assert(err.Error(), Equals, "Invalid tag expression at position 20: expected ')' for '(' at position 8\ntag1 & (tag2 | tag3\n                   ^")
+
+	_, err = compileTagExpression("tag1 & | tag2")
+	c.Assert(err.Error(), Equals, "Invalid tag expression at position 8: expected a tag but found '|'\ntag1 & | tag2\n       ^")
+
+	_, err = compileTagExpression("tag1 & tag2)")
+	c.Assert(err.Error(), Equals, "Invalid tag expression at position 12: unexpected ')'\ntag1 & tag2)\n           ^")
+
+	_, err = compileTagExpression("'tag1")
+	c.Assert(err.Error(), Equals, "Invalid tag expression at position 1: unterminated quoted tag\n'tag1\n^")
+
+	_, err = compileTagExpression("tag1 tag2 | tag3")
+	c.Assert(err.Error(), Equals, "Invalid tag expression at position 6: expected an operator before 'tag2'\ntag1 tag2 | tag3\n     ^")
+
+	_, err = compileTagExpression("(tag1 !tag2)")
+	c.Assert(err.Error(), Equals, "Invalid tag expression at position 7: expected an operator before '!'\n(tag1 !tag2)\n      ^")
+}
@@ -44,8 +44,7 @@ type specRandomizer struct {
 
 func (tagsFilter *tagsFilter) filter(specs []*parser.Specification) []*parser.Specification {
 	if tagsFilter.tagExp != "" {
-		validateTagExpression(tagsFilter.tagExp)
-		specs = filterSpecsByTags(specs, tagsFilter.tagExp)
+		specs = filterSpecsByTags(specs, validateTagExpression(tagsFilter.tagExp))
 	}
 	return specs
 }
@@ -0,0 +1,305 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package filter
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tagExpression is a compiled tag expression. Tag names are matched by isTagPresent.
+//
+//	expression := and ( ( "|" | "||" ) and )*
+//	and        := unary ( ( "&" | "&&" | "," ) unary )*
+//	unary      := "!" unary | "(" expression ")" | tag
+//
+// A tag is either quoted, or runs up to the next operator or space and can have quoted values, like
+// owner='payments team'. Spaces are allowed around the comparison of a key:value tag, like priority <= 2, and
+// between operators, anywhere else they separate tags, which need an operator between them. Quoted text is
+// kept as written.
+type tagExpression interface {
+	evaluate(isTagPresent func(string) bool) bool
+}
+
+type tagOperand struct {
+	name string
+}
+
+type notExpression struct {
+	operand tagExpression
+}
+
+type andExpression struct {
+	left, right tagExpression
+}
+
+type orExpression struct {
+	left, right tagExpression
+}
+
+func (tag *tagOperand) evaluate(isTagPresent func(string) bool) bool {
+	return isTagPresent(tag.name)
+}
+
+func (not *notExpression) evaluate(isTagPresent func(string) bool) bool {
+	return !not.operand.evaluate(isTagPresent)
+}
+
+func (and *andExpression) evaluate(isTagPresent func(string) bool) bool {
+	return and.left.evaluate(isTagPresent) && and.right.evaluate(isTagPresent)
+}
+
+func (or *orExpression) evaluate(isTagPresent func(string) bool) bool {
+	return or.left.evaluate(isTagPresent) || or.right.evaluate(isTagPresent)
+}
+
+// tagOperands returns the tags the expression refers to, including the negated ones.
+func tagOperands(expression tagExpression) []string {
+	switch e := expression.(type) {
+	case *tagOperand:
+		return []string{e.name}
+	case *notExpression:
+		return tagOperands(e.operand)
+	case *andExpression:
+		return append(tagOperands(e.left), tagOperands(e.right)...)
+	case *orExpression:
+		return append(tagOperands(e.left), tagOperands(e.right)...)
+	}
+	return nil
+}
+
+type tagTokenKind int
+
+const (
+	tagToken tagTokenKind = iota
+	andToken
+	orToken
+	notToken
+	openToken
+	closeToken
+	endToken
+)
+
+type tagExpressionToken struct {
+	kind     tagTokenKind
+	value    string
+	position int
+}
+
+type tagExpressionParser struct {
+	expression string
+	tokens     []*tagExpressionToken
+	current    int
+}
+
+func compileTagExpression(expression string) (tagExpression, error) {
+	parser := &tagExpressionParser{expression: expression}
+	if err := parser.tokenize(); err != nil {
+		return nil, err
+	}
+	if parser.peek().kind == endToken {
+		return nil, parser.errorAt(parser.peek().position, "expected a tag")
+	}
+	compiled, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := parser.peek(); token.kind != endToken {
+		return nil, parser.errorAt(token.position, fmt.Sprintf("unexpected '%s'", token.value))
+	}
+	return compiled, nil
+}
+
+func (parser *tagExpressionParser) tokenize() error {
+	expression := parser.expression
+	for i := 0; i < len(expression); {
+		r, size := utf8.DecodeRuneInString(expression[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '&' || r == ',':
+			parser.addToken(andToken, expression, i, i+repeatedLength(expression[i:], r))
+			i += repeatedLength(expression[i:], r)
+		case r == '|':
+			parser.addToken(orToken, expression, i, i+repeatedLength(expression[i:], r))
+			i += repeatedLength(expression[i:], r)
+		case r == '!' && !strings.HasPrefix(expression[i:], "!="):
+			parser.addToken(notToken, expression, i, i+1)
+			i++
+		case r == '(':
+			parser.addToken(openToken, expression, i, i+1)
+			i++
+		case r == ')':
+			parser.addToken(closeToken, expression, i, i+1)
+			i++
+		case r == '"' || r == '\'':
+			end := strings.IndexRune(expression[i+1:], r)
+			if end == -1 {
+				return parser.errorAt(i, "unterminated quoted tag")
+			}
+			parser.tokens = append(parser.tokens, &tagExpressionToken{kind: tagToken, value: expression[i+1 : i+1+end], position: i})
+			i += end + 2
+		default:
+			var value bytes.Buffer
+			end := i
+			afterComparison := false
+			for end < len(expression) && (!isTagOperator(expression[end:]) || isQuote(expression[end])) {
+				if isQuote(expression[end]) {
+					closing := strings.IndexByte(expression[end+1:], expression[end])
+					if closing == -1 {
+						return parser.errorAt(end, "unterminated quoted value")
+					}
+					value.WriteString(expression[end+1 : end+1+closing])
+					end += closing + 2
+					afterComparison = false
+					continue
+				}
+				r, size := utf8.DecodeRuneInString(expression[end:])
+				if unicode.IsSpace(r) {
+					next := len(expression) - len(strings.TrimLeftFunc(expression[end:], unicode.IsSpace))
+					startsComparison := next < len(expression) && (isComparison(expression[next]) || strings.HasPrefix(expression[next:], "!="))
+					if !afterComparison && !startsComparison {
+						break
+					}
+					end = next
+					continue
+				}
+				value.WriteRune(r)
+				afterComparison = isComparison(expression[end])
+				end += size
+			}
+			parser.tokens = append(parser.tokens, &tagExpressionToken{kind: tagToken, value: value.String(), position: i})
+			i = end
+		}
+	}
+	parser.tokens = append(parser.tokens, &tagExpressionToken{kind: endToken, value: "end of expression", position: len(expression)})
+	return nil
+}
+
+func (parser *tagExpressionParser) addToken(kind tagTokenKind, expression string, start int, end int) {
+	parser.tokens = append(parser.tokens, &tagExpressionToken{kind: kind, value: expression[start:end], position: start})
+}
+
+func isTagOperator(text string) bool {
+	switch text[0] {
+	case '&', ',', '|', '(', ')', '"', '\'':
+		return true
+	case '!':
+		return !strings.HasPrefix(text, "!=")
+	}
+	return false
+}
+
+func isComparison(c byte) bool {
+	return c == '<' || c == '>' || c == '='
+}
+
+func isQuote(c byte) bool {
+	return c == '"' || c == '\''
+}
+
+// repeatedLength returns the length of the operator at the start of text, which can be doubled like && and ||
+func repeatedLength(text string, r rune) int {
+	if r != ',' && len(text) > 1 && rune(text[1]) == r {
+		return 2
+	}
+	return 1
+}
+
+func (parser *tagExpressionParser) peek() *tagExpressionToken {
+	return parser.tokens[parser.current]
+}
+
+func (parser *tagExpressionParser) next() *tagExpressionToken {
+	token := parser.tokens[parser.current]
+	if token.kind != endToken {
+		parser.current++
+	}
+	return token
+}
+
+func (parser *tagExpressionParser) parseOr() (tagExpression, error) {
+	left, err := parser.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for parser.peek().kind == orToken {
+		parser.next()
+		right, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orExpression{left, right}
+	}
+	return left, nil
+}
+
+func (parser *tagExpressionParser) parseAnd() (tagExpression, error) {
+	left, err := parser.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for parser.peek().kind == andToken {
+		parser.next()
+		right, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andExpression{left, right}
+	}
+	if token := parser.peek(); token.kind == tagToken || token.kind == notToken || token.kind == openToken {
+		return nil, parser.errorAt(token.position, fmt.Sprintf("expected an operator before '%s'", token.value))
+	}
+	return left, nil
+}
+
+func (parser *tagExpressionParser) parseUnary() (tagExpression, error) {
+	token := parser.next()
+	switch token.kind {
+	case notToken:
+		operand, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpression{operand}, nil
+	case openToken:
+		inner, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := parser.next(); closing.kind != closeToken {
+			return nil, parser.errorAt(closing.position, fmt.Sprintf("expected ')' for '(' at position %d", token.position+1))
+		}
+		return inner, nil
+	case tagToken:
+		if strings.TrimSpace(token.value) == "" {
+			return nil, parser.errorAt(token.position, "empty tag name")
+		}
+		return &tagOperand{token.value}, nil
+	}
+	return nil, parser.errorAt(token.position, fmt.Sprintf("expected a tag but found '%s'", token.value))
+}
+
+// errorAt points at the position of the expression which could not be parsed
+func (parser *tagExpressionParser) errorAt(position int, message string) error {
+	column := utf8.RuneCountInString(parser.expression[:position])
+	return fmt.Errorf("Invalid tag expression at position %d: %s\n%s\n%s^", column+1, message, parser.expression, strings.Repeat(" ", column))
+}
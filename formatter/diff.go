@@ -0,0 +1,150 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const diffContextLines = 3
+
+type diffLine struct {
+	kind byte
+	text string
+}
+
+// UnifiedDiff returns the changes from original to changed in unified diff format, or an empty string if they are the same.
+// The names label the two versions in the diff header.
+func UnifiedDiff(originalName, changedName, original, changed string) string {
+	if original == changed {
+		return ""
+	}
+	lines := diffLines(strings.SplitAfter(original, "\n"), strings.SplitAfter(changed, "\n"))
+	var diff bytes.Buffer
+	diff.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", originalName, changedName))
+	originalLineNo, formattedLineNo := 1, 1
+	for start := 0; start < len(lines); {
+		change := nextChange(lines, start)
+		if change == len(lines) {
+			break
+		}
+		hunkStart := change - diffContextLines
+		if hunkStart < start {
+			hunkStart = start
+		}
+		originalLineNo, formattedLineNo = originalLineNo+hunkStart-start, formattedLineNo+hunkStart-start
+		hunkEnd := hunkEndAt(lines, change)
+		originalCount, formattedCount := 0, 0
+		var hunk bytes.Buffer
+		for _, line := range lines[hunkStart:hunkEnd] {
+			if line.kind != '+' {
+				originalCount++
+			}
+			if line.kind != '-' {
+				formattedCount++
+			}
+			hunk.WriteString(string(line.kind) + line.text)
+			if !strings.HasSuffix(line.text, "\n") {
+				hunk.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		diff.WriteString(fmt.Sprintf("@@ -%s +%s @@\n", hunkRange(originalLineNo, originalCount), hunkRange(formattedLineNo, formattedCount)))
+		diff.Write(hunk.Bytes())
+		originalLineNo, formattedLineNo = originalLineNo+originalCount, formattedLineNo+formattedCount
+		start = hunkEnd
+	}
+	return diff.String()
+}
+
+func nextChange(lines []diffLine, start int) int {
+	for i := start; i < len(lines); i++ {
+		if lines[i].kind != ' ' {
+			return i
+		}
+	}
+	return len(lines)
+}
+
+// hunkEndAt merges changes that are separated by less than twice the context into one hunk.
+func hunkEndAt(lines []diffLine, change int) int {
+	lastChange := change
+	for i := change + 1; i < len(lines) && i <= lastChange+2*diffContextLines; i++ {
+		if lines[i].kind != ' ' {
+			lastChange = i
+		}
+	}
+	end := lastChange + 1 + diffContextLines
+	if end > len(lines) {
+		end = len(lines)
+	}
+	return end
+}
+
+func hunkRange(lineNo, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", lineNo-1)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", lineNo)
+	}
+	return fmt.Sprintf("%d,%d", lineNo, count)
+}
+
+// diffLines finds the longest common subsequence of the two texts and lists the lines removed, added and kept.
+func diffLines(original, formatted []string) []diffLine {
+	original, formatted = withoutEmptyLastLine(original), withoutEmptyLastLine(formatted)
+	common := make([][]int, len(original)+1)
+	for i := range common {
+		common[i] = make([]int, len(formatted)+1)
+	}
+	for i := len(original) - 1; i >= 0; i-- {
+		for j := len(formatted) - 1; j >= 0; j-- {
+			if original[i] == formatted[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else if common[i+1][j] >= common[i][j+1] {
+				common[i][j] = common[i+1][j]
+			} else {
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+	lines := make([]diffLine, 0)
+	i, j := 0, 0
+	for i < len(original) || j < len(formatted) {
+		if i < len(original) && j < len(formatted) && original[i] == formatted[j] {
+			lines = append(lines, diffLine{' ', original[i]})
+			i, j = i+1, j+1
+		} else if j == len(formatted) || (i < len(original) && common[i+1][j] >= common[i][j+1]) {
+			lines = append(lines, diffLine{'-', original[i]})
+			i++
+		} else {
+			lines = append(lines, diffLine{'+', formatted[j]})
+			j++
+		}
+	}
+	return lines
+}
+
+func withoutEmptyLastLine(lines []string) []string {
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -0,0 +1,72 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestUnifiedDiffOfSameText(c *C) {
+	c.Assert(UnifiedDiff("a.spec", "a.spec (formatted)", "Spec\n====\n", "Spec\n====\n"), Equals, "")
+}
+
+func (s *MySuite) TestUnifiedDiffShowsChangesWithContext(c *C) {
+	original := "Spec\n====\n\nScenario\n--------\n* step one \n* step two\n* step three\n* step four\n* step five\n*   step six\n"
+	formatted := "Spec\n====\n\nScenario\n--------\n* step one\n* step two\n* step three\n* step four\n* step five\n* step six\n"
+
+	c.Assert(UnifiedDiff("a.spec", "a.spec (formatted)", original, formatted), Equals, `--- a.spec
++++ a.spec (formatted)
+@@ -3,9 +3,9 @@
+ 
+ Scenario
+ --------
+-* step one 
++* step one
+ * step two
+ * step three
+ * step four
+ * step five
+-*   step six
++* step six
+`)
+}
+
+func (s *MySuite) TestUnifiedDiffSplitsDistantChanges(c *C) {
+	original := "a \nb\nc\nd\ne\nf\ng\nh\ni \n"
+	formatted := "a\nb\nc\nd\ne\nf\ng\nh\ni\n"
+
+	c.Assert(UnifiedDiff("a.spec", "a.spec (formatted)", original, formatted), Equals, `--- a.spec
++++ a.spec (formatted)
+@@ -1,4 +1,4 @@
+-a 
++a
+ b
+ c
+ d
+@@ -6,4 +6,4 @@
+ f
+ g
+ h
+-i 
++i
+`)
+}
+
+func (s *MySuite) TestUnifiedDiffOfMissingNewlineAtEnd(c *C) {
+	c.Assert(UnifiedDiff("a.spec", "a.spec (formatted)", "* step", "* step\n"), Equals, "--- a.spec\n+++ a.spec (formatted)\n@@ -1 +1 @@\n-* step\n\\ No newline at end of file\n+* step\n")
+}
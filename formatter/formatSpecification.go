@@ -24,18 +24,23 @@ import (
 
 type formatter struct {
 	buffer bytes.Buffer
+	style  *formatStyle
+}
+
+func (formatter *formatter) FrontMatter(frontMatter *parser.FrontMatter) {
+	formatter.buffer.WriteString(FormatFrontMatter(frontMatter))
 }
 
 func (formatter *formatter) SpecHeading(specHeading *parser.Heading) {
-	formatter.buffer.WriteString(FormatHeading(specHeading.Value, "="))
+	formatter.buffer.WriteString(formatParsedHeading(specHeading, "=", formatter.style))
 }
 
 func (formatter *formatter) SpecTags(tags *parser.Tags) {
-	formatter.buffer.WriteString(FormatTags(tags))
+	formatter.buffer.WriteString(formatTags(tags, formatter.style))
 }
 
 func (formatter *formatter) DataTable(table *parser.Table) {
-	formatter.buffer.WriteString(FormatTable(table))
+	formatter.buffer.WriteString(formatTable(table, formatter.style))
 }
 
 func (formatter *formatter) ExternalDataTable(extDataTable *parser.DataTable) {
@@ -50,11 +55,20 @@ func (formatter *formatter) TearDown(t *parser.TearDown) {
 	formatter.buffer.WriteString(t.Value + "\n")
 }
 
+func (formatter *formatter) Include(include *parser.Include) {
+	formatter.buffer.WriteString(FormatInclude(include))
+}
+
 func (formatter *formatter) Scenario(scenario *parser.Scenario) {
 }
 
 func (formatter *formatter) ScenarioHeading(scenarioHeading *parser.Heading) {
-	formatter.buffer.WriteString(FormatHeading(scenarioHeading.Value, "-"))
+	if blankLines := formatter.style.blankLinesBeforeScenario; blankLines >= 0 && formatter.buffer.Len() > 0 {
+		text := withBlankLinesAtEnd(formatter.buffer.String(), blankLines)
+		formatter.buffer.Reset()
+		formatter.buffer.WriteString(text)
+	}
+	formatter.buffer.WriteString(formatParsedHeading(scenarioHeading, "-", formatter.style))
 }
 
 func (formatter *formatter) ScenarioTags(scenarioTags *parser.Tags) {
@@ -62,7 +76,7 @@ func (formatter *formatter) ScenarioTags(scenarioTags *parser.Tags) {
 }
 
 func (formatter *formatter) Step(step *parser.Step) {
-	formatter.buffer.WriteString(FormatStep(step))
+	formatter.buffer.WriteString(formatStep(step, formatter.style))
 }
 
 func (formatter *formatter) Comment(comment *parser.Comment) {
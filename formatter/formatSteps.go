@@ -0,0 +1,119 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/parser"
+	"sort"
+	"strings"
+)
+
+type stepsByLine []*parser.Step
+
+func (s stepsByLine) Len() int           { return len(s) }
+func (s stepsByLine) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s stepsByLine) Less(i, j int) bool { return s[i].Span.StartLine > s[j].Span.StartLine }
+
+// FormatStepsIn rewrites only the lines of the given steps in source, leaving the rest of the file as written.
+// It returns false when a step cannot be located in source.
+func FormatStepsIn(source string, steps []*parser.Step) (string, bool) {
+	lines := strings.Split(source, "\n")
+	sorted := make(stepsByLine, len(steps))
+	copy(sorted, steps)
+	sort.Sort(sorted)
+	style := projectStyle()
+	lastStart := len(lines) + 1
+	for _, step := range sorted {
+		start := step.Span.StartLine
+		if start == 0 || start > len(lines) {
+			return "", false
+		}
+		if start == lastStart {
+			continue
+		}
+		end := StepEndLine(lines, step)
+		if end >= lastStart {
+			return "", false
+		}
+		lastStart = start
+		lines = append(lines[:start-1], append(formattedStepLines(lines, step, start, end, style), lines[end:]...)...)
+	}
+	return strings.Join(lines, "\n"), true
+}
+
+func isConceptHeading(step *parser.Step) bool {
+	return len(step.Items) > 0 && step.Items[0] == parser.Item(step)
+}
+
+// StepEndLine is the last line of a step, including its table and text block arguments or the underline of a concept heading.
+func StepEndLine(lines []string, step *parser.Step) int {
+	end := step.Span.StartLine
+	for _, arg := range step.Args {
+		if arg.Span.EndLine > end {
+			end = arg.Span.EndLine
+		}
+	}
+	if isConceptHeading(step) {
+		if end < len(lines) && isUnderline(strings.TrimSpace(lines[end])) {
+			end++
+		}
+		return end
+	}
+	for end < len(lines) {
+		next := strings.TrimSpace(lines[end])
+		if strings.HasPrefix(next, "|") {
+			end++
+		} else if strings.HasPrefix(next, "```") && end == step.Span.StartLine {
+			closing := end + 1
+			for closing < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[closing]), "```") {
+				closing++
+			}
+			if closing == len(lines) {
+				break
+			}
+			end = closing + 1
+		} else {
+			break
+		}
+	}
+	return end
+}
+
+func isUnderline(text string) bool {
+	return len(text) > 0 && (strings.Trim(text, "=") == "" || strings.Trim(text, "-") == "")
+}
+
+func formattedStepLines(lines []string, step *parser.Step, start, end int, style *formatStyle) []string {
+	formatted := formatStep(step, style)
+	if isConceptHeading(step) {
+		formatted = formatConceptHeading(step, style)
+	}
+	firstLine := lines[start-1]
+	indent := firstLine[:len(firstLine)-len(strings.TrimLeft(firstLine, " \t"))]
+	lineEnding := ""
+	if strings.HasSuffix(lines[end-1], "\r") {
+		lineEnding = "\r"
+	}
+	stepLines := strings.Split(strings.TrimSuffix(formatted, "\n"), "\n")
+	for i := range stepLines {
+		stepLines[i] = common.TrimTrailingSpace(stepLines[i]) + lineEnding
+	}
+	stepLines[0] = indent + stepLines[0]
+	return stepLines
+}
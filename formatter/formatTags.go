@@ -0,0 +1,77 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/parser"
+)
+
+type tagsByLine []*parser.Tags
+
+func (s tagsByLine) Len() int           { return len(s) }
+func (s tagsByLine) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s tagsByLine) Less(i, j int) bool { return tagLines(s[i]).StartLine > tagLines(s[j]).StartLine }
+
+// FormatTagsIn rewrites only the tag lines of the given tags in source, leaving the rest of the file as written.
+// It returns false when the tags cannot be located in source.
+func FormatTagsIn(source string, tags []*parser.Tags) (string, bool) {
+	lines := strings.Split(source, "\n")
+	sorted := make(tagsByLine, len(tags))
+	copy(sorted, tags)
+	sort.Sort(sorted)
+	style := projectStyle()
+	lastStart := len(lines) + 1
+	for _, t := range sorted {
+		span := tagLines(t)
+		if span.StartLine == 0 || span.EndLine >= lastStart {
+			return "", false
+		}
+		lastStart = span.StartLine
+		lines = append(lines[:span.StartLine-1], append(formattedTagLines(t, lines[span.EndLine-1], style), lines[span.EndLine:]...)...)
+	}
+	return strings.Join(lines, "\n"), true
+}
+
+// tagLines is the range of lines the tags were parsed from, zero when it is not known.
+func tagLines(tags *parser.Tags) parser.Span {
+	span := parser.Span{}
+	for _, s := range tags.Spans {
+		if span.StartLine == 0 || s.StartLine < span.StartLine {
+			span.StartLine = s.StartLine
+		}
+		if s.EndLine > span.EndLine {
+			span.EndLine = s.EndLine
+		}
+	}
+	return span
+}
+
+func formattedTagLines(tags *parser.Tags, lastLine string, style *formatStyle) []string {
+	lineEnding := ""
+	if strings.HasSuffix(lastLine, "\r") {
+		lineEnding = "\r"
+	}
+	tagLines := strings.Split(strings.TrimSuffix(formatTags(tags, style), "\n"), "\n")
+	for i := range tagLines {
+		tagLines[i] += lineEnding
+	}
+	return tagLines
+}
@@ -0,0 +1,206 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// TextEdit replaces the text in Span with NewText.
+type TextEdit struct {
+	Span    parser.Span `json:"span"`
+	NewText string      `json:"newText"`
+}
+
+// lineEdit replaces the original lines [start, end) with lines.
+type lineEdit struct {
+	start int
+	end   int
+	lines []string
+}
+
+// FormatText formats the contents of a spec or a concept file, told apart by the extension of fileName.
+func FormatText(text string, fileName string) (string, *parser.ParseResult) {
+	if util.IsValidConceptExtension(fileName) {
+		concepts, parseDetails := new(parser.ConceptParser).Parse(text)
+		if parseDetails != nil && parseDetails.Error != nil {
+			return "", &parser.ParseResult{ParseError: parseDetails.Error, FileName: fileName}
+		}
+		conceptDictionary := parser.NewConceptDictionary()
+		if err := conceptDictionary.Add(concepts, fileName); err != nil {
+			return "", &parser.ParseResult{ParseError: err, FileName: fileName}
+		}
+		result := &parser.ParseResult{Ok: true, FileName: fileName}
+		if parseDetails != nil {
+			result.Warnings = parseDetails.Warnings
+		}
+		formatted, ok := FormatConcepts(conceptDictionary)[fileName]
+		if !ok {
+			return text, result
+		}
+		return formatted, result
+	}
+	spec, result := new(parser.SpecParser).Parse(text, new(parser.ConceptDictionary))
+	result.FileName = fileName
+	if !result.Ok {
+		return "", result
+	}
+	return FormatSpecification(spec), result
+}
+
+// FormatStdin formats the spec or concept read from stdin and prints the formatted text, or the text edits as JSON.
+// lineRange is of the form "start-end" and limits formatting to those lines.
+func FormatStdin(fileName string, lineRange string, printEdits bool) {
+	startLine, endLine, err := ParseLineRange(lineRange)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	text, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		logger.Fatal("Failed to read from stdin: %s", err)
+	}
+	formatted, edits, result := FormatTextInRange(string(text), fileName, startLine, endLine)
+	parser.HandleParseResult(result)
+	if !printEdits {
+		fmt.Print(formatted)
+		return
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(edits); err != nil {
+		logger.Fatal("Failed to write text edits: %s", err)
+	}
+}
+
+// ParseLineRange parses a range of lines given as start-end, or a single line.
+func ParseLineRange(lineRange string) (int, int, error) {
+	if lineRange == "" {
+		return 0, 0, nil
+	}
+	bounds := strings.SplitN(lineRange, "-", 2)
+	startLine, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+	endLine := startLine
+	if err == nil && len(bounds) == 2 {
+		endLine, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+	}
+	if err != nil || startLine < 1 || endLine < startLine {
+		return 0, 0, fmt.Errorf("Invalid line range '%s'. Eg: --format-lines 10-20", lineRange)
+	}
+	return startLine, endLine, nil
+}
+
+// FormatTextInRange formats text and keeps only the changes touching the lines from startLine to endLine.
+// A zero startLine or endLine leaves that end of the range open.
+func FormatTextInRange(text string, fileName string, startLine, endLine int) (string, []*TextEdit, *parser.ParseResult) {
+	formatted, result := FormatText(text, fileName)
+	if !result.Ok {
+		return "", nil, result
+	}
+	original := strings.SplitAfter(text, "\n")
+	edits := lineEditsInRange(lineEdits(original, strings.SplitAfter(formatted, "\n")), startLine, endLine)
+	return applyLineEdits(original, edits), textEdits(original, edits), result
+}
+
+// lineEdits groups each run of changed lines into one edit of the original lines.
+func lineEdits(original, formatted []string) []*lineEdit {
+	edits := make([]*lineEdit, 0)
+	var edit *lineEdit
+	originalIndex := 0
+	for _, line := range diffLines(original, formatted) {
+		if line.kind == ' ' {
+			edit = nil
+			originalIndex++
+			continue
+		}
+		if edit == nil {
+			edit = &lineEdit{start: originalIndex, end: originalIndex, lines: make([]string, 0)}
+			edits = append(edits, edit)
+		}
+		if line.kind == '-' {
+			originalIndex++
+			edit.end = originalIndex
+		} else {
+			edit.lines = append(edit.lines, line.text)
+		}
+	}
+	return splitLineByLine(edits)
+}
+
+// splitLineByLine breaks up edits replacing as many lines as they remove, so that each line can be formatted on its own.
+func splitLineByLine(edits []*lineEdit) []*lineEdit {
+	split := make([]*lineEdit, 0)
+	for _, edit := range edits {
+		if edit.end-edit.start != len(edit.lines) {
+			split = append(split, edit)
+			continue
+		}
+		for i, line := range edit.lines {
+			split = append(split, &lineEdit{start: edit.start + i, end: edit.start + i + 1, lines: []string{line}})
+		}
+	}
+	return split
+}
+
+func lineEditsInRange(edits []*lineEdit, startLine, endLine int) []*lineEdit {
+	inRange := make([]*lineEdit, 0)
+	for _, edit := range edits {
+		if endLine > 0 && edit.start+1 > endLine {
+			continue
+		}
+		if startLine > 0 && edit.end < startLine && !(edit.start == edit.end && edit.start+1 == startLine) {
+			continue
+		}
+		inRange = append(inRange, edit)
+	}
+	return inRange
+}
+
+func applyLineEdits(original []string, edits []*lineEdit) string {
+	var result []string
+	next := 0
+	for _, edit := range edits {
+		result = append(result, original[next:edit.start]...)
+		result = append(result, edit.lines...)
+		next = edit.end
+	}
+	result = append(result, original[next:]...)
+	return strings.Join(result, "")
+}
+
+func textEdits(original []string, edits []*lineEdit) []*TextEdit {
+	original = withoutEmptyLastLine(original)
+	textEdits := make([]*TextEdit, 0)
+	for _, edit := range edits {
+		span := parser.Span{StartLine: edit.start + 1, StartColumn: 1, EndLine: edit.end + 1, EndColumn: 1}
+		if edit.end > 0 && edit.end == len(original) && !strings.HasSuffix(original[edit.end-1], "\n") {
+			span.EndLine, span.EndColumn = edit.end, utf8.RuneCountInString(original[edit.end-1])+1
+		}
+		textEdits = append(textEdits, &TextEdit{Span: span, NewText: strings.Join(edit.lines, "")})
+	}
+	return textEdits
+}
@@ -0,0 +1,85 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestFormatTextOfSpec(c *C) {
+	formatted, result := FormatText("Spec\n====\nScenario\n--------\n*   step   one\n", "")
+
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(formatted, Equals, "Spec\n====\nScenario\n--------\n* step   one\n")
+}
+
+func (s *MySuite) TestFormatTextOfConcept(c *C) {
+	formatted, result := FormatText("#  my concept <a>\n*   step <a>\n", "specs/concepts/my.cpt")
+
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(formatted, Equals, "# my concept <a>\n* step <a>\n")
+}
+
+func (s *MySuite) TestFormatTextWithParseError(c *C) {
+	_, result := FormatText("Scenario\n--------\n* step\n", "example.spec")
+
+	c.Assert(result.Ok, Equals, false)
+	c.Assert(result.FileName, Equals, "example.spec")
+}
+
+func (s *MySuite) TestFormatTextInRangeChangesOnlyLinesInRange(c *C) {
+	text := "Spec\n====\nScenario\n--------\n*   step one\n*   step two\n*   step three\n"
+
+	formatted, edits, result := FormatTextInRange(text, "", 6, 6)
+
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(formatted, Equals, "Spec\n====\nScenario\n--------\n*   step one\n* step two\n*   step three\n")
+	c.Assert(len(edits), Equals, 1)
+	c.Assert(edits[0].Span, Equals, parser.Span{StartLine: 6, StartColumn: 1, EndLine: 7, EndColumn: 1})
+	c.Assert(edits[0].NewText, Equals, "* step two\n")
+}
+
+func (s *MySuite) TestFormatTextInRangeGivesEditsForWholeText(c *C) {
+	text := "Spec\n====\nScenario\n--------\n*   step one\n* step two\n*   step three"
+
+	formatted, edits, result := FormatTextInRange(text, "", 0, 0)
+
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(formatted, Equals, "Spec\n====\nScenario\n--------\n* step one\n* step two\n* step three\n")
+	c.Assert(len(edits), Equals, 2)
+	c.Assert(edits[0].Span, Equals, parser.Span{StartLine: 5, StartColumn: 1, EndLine: 6, EndColumn: 1})
+	c.Assert(edits[1].Span, Equals, parser.Span{StartLine: 7, StartColumn: 1, EndLine: 7, EndColumn: 15})
+	c.Assert(edits[1].NewText, Equals, "* step three\n")
+}
+
+func (s *MySuite) TestParseLineRange(c *C) {
+	start, end, err := ParseLineRange("3-10")
+	c.Assert(err, IsNil)
+	c.Assert(start, Equals, 3)
+	c.Assert(end, Equals, 10)
+
+	start, end, err = ParseLineRange("4")
+	c.Assert(err, IsNil)
+	c.Assert(start, Equals, 4)
+	c.Assert(end, Equals, 4)
+
+	_, _, err = ParseLineRange("10-3")
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "Invalid line range '10-3'. Eg: --format-lines 10-20")
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/util"
 	"sort"
@@ -34,14 +35,44 @@ const (
 
 func FormatSpecFiles(specFiles ...string) []*parser.ParseResult {
 	specs, results := parser.ParseSpecFiles(specFiles, &parser.ConceptDictionary{})
+	style := projectStyle()
 	for i, spec := range specs {
-		if err := formatAndSave(spec); err != nil {
+		if err := formatAndSave(spec, style); err != nil {
 			results[i].ParseError = &parser.ParseError{Message: err.Error()}
 		}
 	}
 	return results
 }
 
+// FormatConceptFiles formats and saves the concept files which can be parsed.
+func FormatConceptFiles(conceptFiles ...string) []*parser.ParseResult {
+	conceptDictionary, results := parseConceptFiles(conceptFiles)
+	failed := make(map[string]bool)
+	for _, result := range results {
+		failed[result.FileName] = true
+	}
+	for fileName, concepts := range FormatConcepts(conceptDictionary) {
+		if failed[fileName] {
+			continue
+		}
+		if err := common.SaveFile(fileName, concepts, true); err != nil {
+			results = append(results, &parser.ParseResult{ParseError: &parser.ParseError{Message: err.Error()}, FileName: fileName})
+		}
+	}
+	return results
+}
+
+func parseConceptFiles(conceptFiles []string) (*parser.ConceptDictionary, []*parser.ParseResult) {
+	conceptDictionary := parser.NewConceptDictionary()
+	results := make([]*parser.ParseResult, 0)
+	for _, conceptFile := range conceptFiles {
+		if err := parser.AddConcepts(conceptFile, conceptDictionary); err != nil {
+			results = append(results, &parser.ParseResult{ParseError: err, FileName: conceptFile})
+		}
+	}
+	return conceptDictionary, results
+}
+
 func FormatSpecHeading(specHeading string) string {
 	return FormatHeading(specHeading, "=")
 }
@@ -51,14 +82,20 @@ func FormatScenarioHeading(scenarioHeading string) string {
 }
 
 func FormatStep(step *parser.Step) string {
+	return formatStep(step, projectStyle())
+}
+
+func formatStep(step *parser.Step, style *formatStyle) string {
 	text := step.Value
 	paramCount := strings.Count(text, parser.ParameterPlaceholder)
 	for i := 0; i < paramCount; i++ {
 		argument := step.Args[i]
 		formattedArg := ""
 		if argument.ArgType == parser.TableArg {
-			formattedTable := FormatTable(&argument.Table)
+			formattedTable := formatTable(&argument.Table, style)
 			formattedArg = fmt.Sprintf("\n%s", formattedTable)
+		} else if argument.ArgType == parser.TextBlock {
+			formattedArg = fmt.Sprintf("\n%s", FormatTextBlock(argument))
 		} else if argument.ArgType == parser.Dynamic {
 			formattedArg = fmt.Sprintf("<%s>", parser.GetUnescapedString(argument.Value))
 		} else if argument.ArgType == parser.SpecialString || argument.ArgType == parser.SpecialTable {
@@ -93,31 +130,81 @@ func FormatConcept(protoConcept *gauge_messages.ProtoConcept) string {
 	return conceptText + "\n"
 }
 
+func FormatTextBlock(textBlock *parser.StepArg) string {
+	if textBlock.Value == "" {
+		return fmt.Sprintf("```%s\n```\n", textBlock.Name)
+	}
+	return fmt.Sprintf("```%s\n%s\n```\n", textBlock.Name, textBlock.Value)
+}
+
 func FormatHeading(heading, headingChar string) string {
 	trimmedHeading := strings.TrimSpace(heading)
 	length := len(trimmedHeading)
 	return fmt.Sprintf("%s\n%s\n", trimmedHeading, getRepeatedChars(headingChar, length))
 }
 
+func formatAtxHeading(heading string, level int) string {
+	return fmt.Sprintf("%s %s\n", getRepeatedChars("#", level), strings.TrimSpace(heading))
+}
+
+// formatParsedHeading keeps the markdown of a heading as it was written, unless its text has changed
+// or the project asks for a heading style.
+func formatParsedHeading(heading *parser.Heading, headingChar string, style *formatStyle) string {
+	switch style.headingStyle {
+	case setextHeadings:
+		return FormatHeading(heading.Value, headingChar)
+	case atxHeadings:
+		if headingChar == "=" {
+			return formatAtxHeading(heading.Value, 1)
+		}
+		return formatAtxHeading(heading.Value, 2)
+	}
+	if heading.LineText == "" {
+		return FormatHeading(heading.Value, headingChar)
+	}
+	lines := strings.Split(heading.LineText, "\n")
+	if strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(lines[0]), "#")) != strings.TrimSpace(heading.Value) {
+		return FormatHeading(heading.Value, headingChar)
+	}
+	var b bytes.Buffer
+	for _, line := range lines {
+		b.WriteString(common.TrimTrailingSpace(line) + "\n")
+	}
+	return string(b.Bytes())
+}
+
 func FormatTable(table *parser.Table) string {
+	return formatTable(table, projectStyle())
+}
+
+func formatTable(table *parser.Table, style *formatStyle) string {
 	columnToWidthMap := make(map[int]int)
 	for i, header := range table.Headers {
 		//table.get(header) returns a list of cells in that particular column
 		cells := table.Get(header)
 		columnToWidthMap[i] = findLongestCellWidth(cells, len(header))
+		if columnToWidthMap[i] < style.tableMinCellWidth {
+			columnToWidthMap[i] = style.tableMinCellWidth
+		}
 	}
 
 	var tableStringBuffer bytes.Buffer
 	tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", TABLE_LEFT_SPACING)))
 	for i, header := range table.Headers {
 		width := columnToWidthMap[i]
-		tableStringBuffer.WriteString(fmt.Sprintf("%s|", addPaddingToCell(header, width)))
+		tableStringBuffer.WriteString(fmt.Sprintf("%s|", alignCell(header, width, style.tableAlignment)))
 	}
 
 	tableStringBuffer.WriteString("\n")
 	tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", TABLE_LEFT_SPACING)))
-	for i, _ := range table.Headers {
+	for i, header := range table.Headers {
 		width := columnToWidthMap[i]
+		if style.tableAlignment == alignNone {
+			width = len(header)
+			if width == 0 {
+				width = 1
+			}
+		}
 		cell := getRepeatedChars("-", width)
 		tableStringBuffer.WriteString(fmt.Sprintf("%s|", addPaddingToCell(cell, width)))
 	}
@@ -127,7 +214,7 @@ func FormatTable(table *parser.Table) string {
 		tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", TABLE_LEFT_SPACING)))
 		for i, cell := range row {
 			width := columnToWidthMap[i]
-			tableStringBuffer.WriteString(fmt.Sprintf("%s|", addPaddingToCell(cell, width)))
+			tableStringBuffer.WriteString(fmt.Sprintf("%s|", alignCell(cell, width, style.tableAlignment)))
 		}
 		tableStringBuffer.WriteString("\n")
 	}
@@ -135,6 +222,19 @@ func FormatTable(table *parser.Table) string {
 	return string(tableStringBuffer.Bytes())
 }
 
+func alignCell(cellValue string, width int, alignment string) string {
+	padding := width - len(cellValue)
+	switch alignment {
+	case alignNone:
+		return cellValue
+	case alignRight:
+		return getRepeatedChars(" ", padding) + cellValue
+	case alignCenter:
+		return getRepeatedChars(" ", padding/2) + cellValue + getRepeatedChars(" ", padding-padding/2)
+	}
+	return addPaddingToCell(cellValue, width)
+}
+
 func addPaddingToCell(cellValue string, width int) string {
 	padding := getRepeatedChars(" ", width-len(cellValue))
 	return fmt.Sprintf("%s%s", cellValue, padding)
@@ -159,18 +259,24 @@ func FormatComment(comment *parser.Comment) string {
 }
 
 func FormatTags(tags *parser.Tags) string {
+	return formatTags(tags, projectStyle())
+}
+
+func formatTags(tags *parser.Tags, style *formatStyle) string {
 	if tags == nil || len(tags.Values) == 0 {
 		return ""
 	}
 	var b bytes.Buffer
-	b.WriteString("tags: ")
-	for i, tag := range tags.Values {
-		b.WriteString(tag)
-		if (i + 1) != len(tags.Values) {
-			b.WriteString(", ")
+	line := "tags: " + tags.Values[0]
+	for _, tag := range tags.Values[1:] {
+		if style.wrapTags && len(line)+len(", "+tag) > style.maxLineLength {
+			b.WriteString(line + "\n")
+			line = "tags: " + tag
+		} else {
+			line += ", " + tag
 		}
 	}
-	b.WriteString("\n")
+	b.WriteString(line + "\n")
 	return string(b.Bytes())
 }
 
@@ -184,8 +290,22 @@ func FormatExternalDataTable(dataTable *parser.DataTable) string {
 	return string(b.Bytes())
 }
 
-func formatAndSave(spec *parser.Specification) error {
-	formatted := FormatSpecification(spec)
+func FormatInclude(include *parser.Include) string {
+	return fmt.Sprintf("include: %s\n", include.Value)
+}
+
+func FormatFrontMatter(frontMatter *parser.FrontMatter) string {
+	var b bytes.Buffer
+	b.WriteString("---\n")
+	for _, line := range frontMatter.Lines {
+		b.WriteString(common.TrimTrailingSpace(line) + "\n")
+	}
+	b.WriteString("---\n")
+	return string(b.Bytes())
+}
+
+func formatAndSave(spec *parser.Specification, style *formatStyle) error {
+	formatted := formatSpecification(spec, style)
 	if err := common.SaveFile(spec.FileName, formatted, true); err != nil {
 		return err
 	}
@@ -193,8 +313,12 @@ func formatAndSave(spec *parser.Specification) error {
 }
 
 func FormatSpecification(specification *parser.Specification) string {
+	return formatSpecification(specification, projectStyle())
+}
+
+func formatSpecification(specification *parser.Specification, style *formatStyle) string {
 	var formattedSpec bytes.Buffer
-	formatter := &formatter{buffer: formattedSpec}
+	formatter := &formatter{buffer: formattedSpec, style: style}
 	specification.Traverse(formatter)
 	return string(formatter.buffer.Bytes())
 }
@@ -209,25 +333,40 @@ func sortConcepts(conceptDictionary *parser.ConceptDictionary, conceptMap map[st
 	return concepts
 }
 
-func formatConceptSteps(conceptMap map[string]string, concept *parser.Concept) {
-	conceptMap[concept.FileName] += strings.TrimSpace(strings.Replace(FormatStep(concept.ConceptStep), "*", "#", 1)) + "\n"
+func formatConceptHeading(concept *parser.Step, style *formatStyle) string {
+	heading := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(formatStep(concept, style)), "*"))
+	if style.headingStyle == setextHeadings {
+		return FormatHeading(heading, "=")
+	}
+	return formatAtxHeading(heading, 1)
+}
+
+func formatConceptSteps(conceptMap map[string]string, concept *parser.Concept, style *formatStyle) {
+	conceptMap[concept.FileName] += formatConceptHeading(concept.ConceptStep, style)
 	for i := 1; i < len(concept.ConceptStep.Items); i++ {
-		conceptMap[concept.FileName] += formatItem(concept.ConceptStep.Items[i])
+		conceptMap[concept.FileName] += formatItem(concept.ConceptStep.Items[i], style)
 	}
 }
 
 func FormatConcepts(conceptDictionary *parser.ConceptDictionary) map[string]string {
+	return formatConcepts(conceptDictionary, projectStyle())
+}
+
+func formatConcepts(conceptDictionary *parser.ConceptDictionary, style *formatStyle) map[string]string {
 	conceptMap := make(map[string]string)
 	for _, concept := range sortConcepts(conceptDictionary, conceptMap) {
+		if conceptMap[concept.FileName] != "" && style.blankLinesBeforeScenario >= 0 {
+			conceptMap[concept.FileName] = withBlankLinesAtEnd(conceptMap[concept.FileName], style.blankLinesBeforeScenario)
+		}
 		for _, comment := range concept.ConceptStep.PreComments {
 			conceptMap[concept.FileName] += FormatComment(comment)
 		}
-		formatConceptSteps(conceptMap, concept)
+		formatConceptSteps(conceptMap, concept, style)
 	}
 	return conceptMap
 }
 
-func formatItem(item parser.Item) string {
+func formatItem(item parser.Item, style *formatStyle) string {
 	switch item.Kind() {
 	case parser.CommentKind:
 		comment := item.(*parser.Comment)
@@ -237,17 +376,30 @@ func formatItem(item parser.Item) string {
 		return fmt.Sprintf("%s\n", comment.Value)
 	case parser.StepKind:
 		step := item.(*parser.Step)
-		return FormatStep(step)
+		return formatStep(step, style)
 	case parser.DataTableKind:
 		dataTable := item.(*parser.DataTable)
-		return FormatTable(&dataTable.Table)
+		return formatTable(&dataTable.Table, style)
 	case parser.TagKind:
 		tags := item.(*parser.Tags)
-		return FormatTags(tags)
+		return formatTags(tags, style)
 	}
 	return ""
 }
 
+// withBlankLinesAtEnd replaces the blank lines at the end of text with count blank lines.
+func withBlankLinesAtEnd(text string, count int) string {
+	lines := strings.SplitAfter(text, "\n")
+	end := len(lines)
+	for end > 0 && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+	if end == 0 {
+		return ""
+	}
+	return strings.Join(lines[:end], "") + getRepeatedChars("\n", count)
+}
+
 func getRepeatedChars(character string, repeatCount int) string {
 	formatted := ""
 	for i := 0; i < repeatCount; i++ {
@@ -259,5 +411,43 @@ func getRepeatedChars(character string, repeatCount int) string {
 func FormatSpecFilesIn(filesLocation string) {
 	specFiles := util.GetSpecFiles(filesLocation)
 	parseResults := FormatSpecFiles(specFiles...)
+	parseResults = append(parseResults, FormatConceptFiles(util.GetConceptFiles(filesLocation)...)...)
 	parser.HandleParseResult(parseResults...)
 }
+
+// CheckFormatOfFilesIn prints a diff for every spec and concept file that is not formatted, without changing any of them.
+// It returns false if any file would be changed by formatting.
+func CheckFormatOfFilesIn(filesLocation string) bool {
+	specs, parseResults := parser.ParseSpecFiles(util.GetSpecFiles(filesLocation), &parser.ConceptDictionary{})
+	conceptDictionary, conceptResults := parseConceptFiles(util.GetConceptFiles(filesLocation))
+	parser.HandleParseResult(append(parseResults, conceptResults...)...)
+
+	style := projectStyle()
+	formatted := make(map[string]string)
+	for _, spec := range specs {
+		formatted[spec.FileName] = formatSpecification(spec, style)
+	}
+	for fileName, concepts := range formatConcepts(conceptDictionary, style) {
+		formatted[fileName] = concepts
+	}
+	fileNames := make([]string, 0)
+	for fileName := range formatted {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	allFormatted := true
+	for _, fileName := range fileNames {
+		original, err := common.ReadFileContents(fileName)
+		if err != nil {
+			logger.Error("Failed to read '%s': %s", fileName, err)
+			allFormatted = false
+			continue
+		}
+		if diff := UnifiedDiff(fileName, fileName+" (formatted)", original, formatted[fileName]); diff != "" {
+			fmt.Print(diff)
+			allFormatted = false
+		}
+	}
+	return allFormatted
+}
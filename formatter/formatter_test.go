@@ -18,8 +18,12 @@
 package formatter
 
 import (
+	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/parser"
 	. "gopkg.in/check.v1"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -172,6 +176,34 @@ Scenario Heading
 `)
 }
 
+func (s *MySuite) TestFormattingScenarioDataTable(c *C) {
+	os.Setenv("allow_scenario_datatable", "true")
+	defer os.Unsetenv("allow_scenario_datatable")
+
+	tokens := []*parser.Token{
+		&parser.Token{Kind: parser.SpecKind, Value: "My Spec Heading", LineNo: 1},
+		&parser.Token{Kind: parser.ScenarioKind, Value: "Scenario Heading", LineNo: 3},
+		&parser.Token{Kind: parser.TableHeader, Args: []string{"id", "name"}, LineText: " |id|name|"},
+		&parser.Token{Kind: parser.TableRow, Args: []string{"1", "foo"}, LineText: " |1|foo|"},
+		&parser.Token{Kind: parser.TableRow, Args: []string{"2", "bar"}, LineText: "|2|bar|"},
+		&parser.Token{Kind: parser.StepKind, Value: "Example step {dynamic}", Args: []string{"name"}, LineNo: 5, LineText: "Example step <name>"},
+	}
+
+	spec, _ := new(parser.SpecParser).CreateSpecification(tokens, new(parser.ConceptDictionary))
+	formatted := FormatSpecification(spec)
+	c.Assert(formatted, Equals,
+		`My Spec Heading
+===============
+Scenario Heading
+----------------
+     |id|name|
+     |--|----|
+     |1 |foo |
+     |2 |bar |
+* Example step <name>
+`)
+}
+
 func (s *MySuite) TestFormatSpecificationWithTableContainingDynamicParameters(c *C) {
 	tokens := []*parser.Token{
 		&parser.Token{Kind: parser.SpecKind, Value: "Spec Heading", LineNo: 1},
@@ -205,3 +237,118 @@ Scenario Heading
      |2 |bar  |
 `)
 }
+
+func (s *MySuite) TestFormattingSpecWithInclude(c *C) {
+	spec := &parser.Specification{Heading: &parser.Heading{Value: "My Spec Heading"}}
+	spec.Items = []parser.Item{&parser.Include{Value: "shared/login.ctx", LineNo: 2, LineText: "include :shared/login.ctx"}}
+
+	c.Assert(FormatSpecification(spec), Equals, `My Spec Heading
+===============
+include: shared/login.ctx
+`)
+}
+
+func (s *MySuite) TestFormatStepWithTextBlock(c *C) {
+	step := &parser.Step{Value: "post request {}", HasTextBlock: true,
+		Args: []*parser.StepArg{&parser.StepArg{ArgType: parser.TextBlock, Name: "json", Value: "{\n  \"id\": 1\n}"}}}
+
+	c.Assert(FormatStep(step), Equals, "* post request \n```json\n{\n  \"id\": 1\n}\n```\n")
+}
+
+func (s *MySuite) TestFormattingSpecWithFrontMatter(c *C) {
+	specText := "---\nowner: payments   \njira: [PAY-12]\n---\nMy Spec Heading\n===============\nScenario\n--------\n* do something\n"
+	spec, result := new(parser.SpecParser).Parse(specText, new(parser.ConceptDictionary))
+	c.Assert(result.Ok, Equals, true)
+
+	c.Assert(FormatSpecification(spec), Equals, `---
+owner: payments
+jira: [PAY-12]
+---
+My Spec Heading
+===============
+Scenario
+--------
+* do something
+`)
+}
+
+func (s *MySuite) TestFormatConceptWithDefaultValues(c *C) {
+	dictionary := new(parser.ConceptDictionary)
+	concepts, parseRes := new(parser.ConceptParser).Parse("# login as <user> with role <role>\n|role|\n|---|\n|admin|\n* login <user> <role>\n")
+	c.Assert(parseRes.Error, IsNil)
+	dictionary.Add(concepts, "file.cpt")
+
+	formatted := FormatConcepts(dictionary)
+	c.Assert(formatted["file.cpt"], Equals, `# login as <user> with role <role>
+     |role |
+     |-----|
+     |admin|
+* login <user> <role>
+`)
+}
+
+func (s *MySuite) TestFormattingSpecKeepsHeadingMarkdown(c *C) {
+	specText := "# My Spec Heading\nScenario\n--\n* do something\n"
+	spec, result := new(parser.SpecParser).Parse(specText, new(parser.ConceptDictionary))
+	c.Assert(result.Ok, Equals, true)
+
+	c.Assert(FormatSpecification(spec), Equals, "# My Spec Heading\nScenario\n--\n* do something\n")
+}
+
+func (s *MySuite) TestFormatStepsInRewritesOnlyGivenSteps(c *C) {
+	specText := "# My Spec Heading\n\n## Scenario\n* say   \"hello\"\n  * say \"bye\"\n     |id|\n     |--|\n     |1|\nSome *notes* here\n"
+	spec, result := new(parser.SpecParser).Parse(specText, new(parser.ConceptDictionary))
+	c.Assert(result.Ok, Equals, true)
+	steps := spec.Scenarios[0].Steps
+	steps[0].Args[0].Value = "hi"
+	steps[1].Args[0].Value = "ciao"
+
+	formatted, ok := FormatStepsIn(specText, steps[1:])
+
+	c.Assert(ok, Equals, true)
+	c.Assert(formatted, Equals, "# My Spec Heading\n\n## Scenario\n* say   \"hello\"\n  * say \"ciao\"\n     |id|\n     |--|\n     |1 |\nSome *notes* here\n")
+}
+
+func (s *MySuite) TestFormatStepsInConceptHeading(c *C) {
+	conceptText := "Log in as <user>\n================\n* enter <user>\r\n"
+	concepts, parseRes := new(parser.ConceptParser).Parse(conceptText)
+	c.Assert(parseRes.Error, IsNil)
+	concepts[0].Value = "Sign in as {}"
+
+	formatted, ok := FormatStepsIn(conceptText, []*parser.Step{concepts[0]})
+
+	c.Assert(ok, Equals, true)
+	c.Assert(formatted, Equals, "# Sign in as <user>\n* enter <user>\r\n")
+}
+
+func (s *MySuite) TestFormatStepsInFailsForStepsWithoutSpan(c *C) {
+	_, ok := FormatStepsIn("* step\n", []*parser.Step{&parser.Step{Value: "step"}})
+
+	c.Assert(ok, Equals, false)
+}
+
+func (s *MySuite) TestFormatTagsInRewritesOnlyGivenTags(c *C) {
+	specText := "# My Spec Heading\r\ntags: a,  b\r\ntags: c\r\n\r\n## Scenario\r\ntags: d\r\n* step   one\r\n"
+	spec, result := new(parser.SpecParser).Parse(specText, new(parser.ConceptDictionary))
+	c.Assert(result.Ok, Equals, true)
+	spec.Tags.Values = []string{"x", "b", "c"}
+
+	formatted, ok := FormatTagsIn(specText, []*parser.Tags{spec.Tags})
+
+	c.Assert(ok, Equals, true)
+	c.Assert(formatted, Equals, "# My Spec Heading\r\ntags: x, b, c\r\n\r\n## Scenario\r\ntags: d\r\n* step   one\r\n")
+}
+
+func (s *MySuite) TestFormatConceptFilesSavesFormattedConcepts(c *C) {
+	dir, err := ioutil.TempDir("", "format")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	conceptFile := filepath.Join(dir, "login.cpt")
+	c.Assert(ioutil.WriteFile(conceptFile, []byte("# log in as <user>\n* enter\n |name|\n |<user>|\n"), 0644), IsNil)
+
+	results := FormatConceptFiles(conceptFile)
+
+	c.Assert(len(results), Equals, 0)
+	contents, _ := common.ReadFileContents(conceptFile)
+	c.Assert(contents, Equals, "# log in as <user>\n* enter \n     |name  |\n     |------|\n     |<user>|\n")
+}
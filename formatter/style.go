@@ -0,0 +1,95 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"github.com/getgauge/gauge/logger"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Properties of the project environment which change how specs and concepts are formatted.
+const (
+	headingStyleProperty             = "format_heading_style"
+	tableAlignmentProperty           = "format_table_alignment"
+	tableMinCellWidthProperty        = "format_table_min_cell_width"
+	blankLinesBeforeScenarioProperty = "format_blank_lines_before_scenario"
+	wrapTagsProperty                 = "format_wrap_tags"
+	maxLineLengthProperty            = "format_max_line_length"
+
+	setextHeadings = "setext"
+	atxHeadings    = "atx"
+
+	alignLeft   = "left"
+	alignRight  = "right"
+	alignCenter = "center"
+	alignNone   = "none"
+
+	defaultMaxLineLength = 120
+)
+
+type formatStyle struct {
+	// headingStyle is empty when headings are kept as written.
+	headingStyle      string
+	tableAlignment    string
+	tableMinCellWidth int
+	// blankLinesBeforeScenario is negative when blank lines are kept as written.
+	blankLinesBeforeScenario int
+	wrapTags                 bool
+	maxLineLength            int
+}
+
+// projectStyle reads the formatting style from the environment of the project.
+func projectStyle() *formatStyle {
+	return &formatStyle{
+		headingStyle:             getChoice(headingStyleProperty, "", setextHeadings, atxHeadings),
+		tableAlignment:           getChoice(tableAlignmentProperty, alignLeft, alignRight, alignCenter, alignNone),
+		tableMinCellWidth:        getNumber(tableMinCellWidthProperty, 0),
+		blankLinesBeforeScenario: getNumber(blankLinesBeforeScenarioProperty, -1),
+		wrapTags:                 getChoice(wrapTagsProperty, "false", "true") == "true",
+		maxLineLength:            getNumber(maxLineLengthProperty, defaultMaxLineLength),
+	}
+}
+
+func getChoice(property string, defaultValue string, choices ...string) string {
+	value := strings.ToLower(strings.TrimSpace(os.Getenv(property)))
+	if value == "" || value == defaultValue {
+		return defaultValue
+	}
+	for _, choice := range choices {
+		if value == choice {
+			return value
+		}
+	}
+	logger.Warning("Incorrect value for %s in property file. Expected one of %s, got %s", property, strings.Join(choices, ", "), value)
+	return defaultValue
+}
+
+func getNumber(property string, defaultValue int) int {
+	value := strings.TrimSpace(os.Getenv(property))
+	if value == "" {
+		return defaultValue
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 0 {
+		logger.Warning("Incorrect value for %s in property file. Cannot convert %s to a number", property, value)
+		return defaultValue
+	}
+	return number
+}
@@ -0,0 +1,134 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package formatter
+
+import (
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+	"os"
+)
+
+func withProperties(properties map[string]string, format func()) {
+	for name, value := range properties {
+		os.Setenv(name, value)
+	}
+	defer func() {
+		for name := range properties {
+			os.Unsetenv(name)
+		}
+	}()
+	format()
+}
+
+func parseSpec(c *C, specText string) *parser.Specification {
+	spec, result := new(parser.SpecParser).Parse(specText, new(parser.ConceptDictionary))
+	c.Assert(result.Ok, Equals, true)
+	return spec
+}
+
+func (s *MySuite) TestFormatSpecificationWithAtxHeadings(c *C) {
+	spec := parseSpec(c, "Spec\n====\nScenario\n--------\n* step\n")
+
+	withProperties(map[string]string{headingStyleProperty: "atx"}, func() {
+		c.Assert(FormatSpecification(spec), Equals, "# Spec\n## Scenario\n* step\n")
+	})
+}
+
+func (s *MySuite) TestFormatSpecificationWithSetextHeadings(c *C) {
+	spec := parseSpec(c, "# Spec\n## Scenario\n* step\n")
+
+	withProperties(map[string]string{headingStyleProperty: "Setext"}, func() {
+		c.Assert(FormatSpecification(spec), Equals, "Spec\n====\nScenario\n--------\n* step\n")
+	})
+}
+
+func (s *MySuite) TestFormatConceptsWithSetextHeadings(c *C) {
+	dictionary := parser.NewConceptDictionary()
+	concepts, parseRes := new(parser.ConceptParser).Parse("# my concept <a>\n* step <a>\n")
+	c.Assert(parseRes.Error, IsNil)
+	dictionary.Add(concepts, "file.cpt")
+
+	withProperties(map[string]string{headingStyleProperty: "setext"}, func() {
+		c.Assert(FormatConcepts(dictionary)["file.cpt"], Equals, "my concept <a>\n==============\n* step <a>\n")
+	})
+}
+
+func (s *MySuite) TestFormatTableWithAlignment(c *C) {
+	table := &parser.Table{}
+	table.AddHeaders([]string{"id", "name"})
+	table.AddRowValues([]string{"1", "foo"})
+
+	withProperties(map[string]string{tableAlignmentProperty: "right", tableMinCellWidthProperty: "3"}, func() {
+		c.Assert(FormatTable(table), Equals, "     | id|name|\n     |---|----|\n     |  1| foo|\n")
+	})
+	withProperties(map[string]string{tableAlignmentProperty: "center", tableMinCellWidthProperty: "5"}, func() {
+		c.Assert(FormatTable(table), Equals, "     | id  |name |\n     |-----|-----|\n     |  1  | foo |\n")
+	})
+	withProperties(map[string]string{tableAlignmentProperty: "none"}, func() {
+		c.Assert(FormatTable(table), Equals, "     |id|name|\n     |--|----|\n     |1|foo|\n")
+	})
+}
+
+func (s *MySuite) TestFormatSpecificationWithBlankLinesBeforeScenarios(c *C) {
+	spec := parseSpec(c, "# Spec\n## First\n* step\n\n\n\n## Second\n* step\n")
+
+	withProperties(map[string]string{blankLinesBeforeScenarioProperty: "1"}, func() {
+		c.Assert(FormatSpecification(spec), Equals, "# Spec\n\n## First\n* step\n\n## Second\n* step\n")
+	})
+}
+
+func (s *MySuite) TestFormatConceptsWithBlankLinesBetweenConcepts(c *C) {
+	dictionary := parser.NewConceptDictionary()
+	concepts, parseRes := new(parser.ConceptParser).Parse("# first\n* step\n# second\n* step\n")
+	c.Assert(parseRes.Error, IsNil)
+	dictionary.Add(concepts, "file.cpt")
+
+	withProperties(map[string]string{blankLinesBeforeScenarioProperty: "2"}, func() {
+		c.Assert(FormatConcepts(dictionary)["file.cpt"], Equals, "# first\n* step\n\n\n# second\n* step\n")
+	})
+}
+
+func (s *MySuite) TestFormatTagsWrappedAtMaxLineLength(c *C) {
+	spec := parseSpec(c, "# Spec\ntags: login, smoke, regression, payments\n## Scenario\n* step\n")
+
+	withProperties(map[string]string{wrapTagsProperty: "true", maxLineLengthProperty: "20"}, func() {
+		formatted := FormatSpecification(spec)
+		c.Assert(formatted, Equals, "# Spec\ntags: login, smoke\ntags: regression\ntags: payments\n## Scenario\n* step\n")
+		c.Assert(parseSpec(c, formatted).Tags.Values, DeepEquals, []string{"login", "smoke", "regression", "payments"})
+	})
+}
+
+func (s *MySuite) TestIncorrectStylePropertiesUseDefaults(c *C) {
+	withProperties(map[string]string{headingStyleProperty: "bold", tableMinCellWidthProperty: "wide"}, func() {
+		style := projectStyle()
+		c.Assert(style.headingStyle, Equals, "")
+		c.Assert(style.tableMinCellWidth, Equals, 0)
+		c.Assert(style.tableAlignment, Equals, alignLeft)
+		c.Assert(style.blankLinesBeforeScenario, Equals, -1)
+		c.Assert(style.maxLineLength, Equals, defaultMaxLineLength)
+	})
+}
+
+func (s *MySuite) TestFormatSpecificationUsesTheStyleItIsGiven(c *C) {
+	spec := parseSpec(c, "Spec\n====\n|id|\n|1|\nScenario\n--------\ntags: a, b\n* step\n")
+	style := &formatStyle{headingStyle: atxHeadings, tableAlignment: alignNone, wrapTags: true, maxLineLength: 8}
+
+	withProperties(map[string]string{headingStyleProperty: "setext"}, func() {
+		c.Assert(formatSpecification(spec, style), Equals, "# Spec\n     |id|\n     |--|\n     |1|\n## Scenario\ntags: a\ntags: b\n* step\n")
+	})
+}
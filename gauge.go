@@ -56,11 +56,30 @@ var installZip = flag.String([]string{"-file", "f"}, "", "Installs the plugin fr
 var currentEnv = flag.String([]string{"-env"}, "default", "Specifies the environment. If not specified, default will be used")
 var addPlugin = flag.String([]string{"-add-plugin"}, "", "Adds the specified non-language plugin to the current project")
 var pluginArgs = flag.String([]string{"-plugin-args"}, "", "Specified additional arguments to the plugin. This is used together with --add-plugin")
-var specFilesToFormat = flag.String([]string{"-format"}, "", "Formats the specified spec files")
-var executeTags = flag.String([]string{"-tags"}, "", "Executes the specs and scenarios tagged with given tags. Eg: gauge --tags tag1,tag2 specs")
-var tableRows = flag.String([]string{"-table-rows"}, "", "Executes the specs and scenarios only for the selected rows. Eg: gauge --table-rows \"1-3\" specs/hello.spec")
+var specFilesToFormat = flag.String([]string{"-format"}, "", "Formats the specified spec files. Use - to format a spec or concept read from stdin. Eg: gauge --format - < specs/example.spec")
+var specFilesToCheckFormat = flag.String([]string{"-format-check"}, "", "Prints the changes formatting the specified spec files would make, without saving them, and fails if there are any. Eg: gauge --format-check specs")
+var formatLines = flag.String([]string{"-format-lines"}, "", "Formats only the given range of lines. This is used with --format -. Eg: gauge --format - --format-lines 10-20 < specs/example.spec")
+var formatEdits = flag.Bool([]string{"-edits"}, false, "Prints the changes made by formatting as text edits in JSON, instead of the formatted text. This is used with --format -")
+var stdinFileName = flag.String([]string{"-stdin-file-name"}, "", "Name of the file given through stdin. Files with .cpt extension are formatted as concepts. This is used with --format -")
+var executeTags = flag.String([]string{"-tags"}, "", "Executes the specs and scenarios tagged with given tags. Eg: gauge --tags tag1,tag2 specs, gauge --tags \"owner=payments && priority<=2 && smoke-*\" specs")
+var where = flag.String([]string{"-where"}, "", "Executes the specs whose front-matter matches the given expression. Eg: gauge --where \"component=checkout & owner!=search\" specs")
+var tableRows = flag.String([]string{"-table-rows"}, "", "Executes the specs and scenarios only for the selected rows, by range or by column values. Column values select rows only in the specs whose table has those columns. Eg: gauge --table-rows \"1-3\" specs/hello.spec, gauge --table-rows \"region=eu && tier!=free\" specs")
 var apiPort = flag.String([]string{"-api-port"}, "", "Specifies the api port to be used. Eg: gauge --daemonize --api-port 7777")
-var refactorSteps = flag.String([]string{"-refactor"}, "", "Refactor steps")
+var refactorSteps = flag.String([]string{"-refactor"}, "", "Refactor steps. Parameters are matched by name, and a new parameter can have a default value to fill in at every usage. Eg: gauge --refactor \"pay <amount>\" \"pay <amount> to <user=admin>\"")
+var previewRefactoring = flag.Bool([]string{"-preview"}, false, "Prints the changes refactoring would make as diffs, without changing any file. This is used with the refactoring commands. Eg: gauge --refactor \"old step\" \"new step\" --preview")
+var refactorBatch = flag.String([]string{"-refactor-batch"}, "", "Refactors all the steps listed in a file, one \"old step => new step\" per line, in one pass. Eg: gauge --refactor-batch renames.txt")
+var refactorTags = flag.String([]string{"-refactor-tag"}, "", "Renames tags in all specs and scenarios, merging them into the new tag. Separate multiple old tags with commas. Eg: gauge --refactor-tag \"Smoke,smoke-test\" smoke")
+var tagReport = flag.Bool([]string{"-tag-report"}, false, "Lists the tags used in specs, the tags used only once and groups of tags that look like duplicates. With --tags, also lists the tags the expression does not use. Eg: gauge --tag-report --tags \"smoke | login\"")
+var inlineConcept = flag.String([]string{"-inline-concept"}, "", "Replaces the usages of a concept by its steps. Eg: gauge --inline-concept \"log in as <user>\"")
+var conceptUsage = flag.String([]string{"-inline-concept-usage"}, "", "Inlines only the usage of the concept at the given line. This is used with --inline-concept. Eg: gauge --inline-concept \"log in as <user>\" --inline-concept-usage specs/example.spec:12")
+var deleteConcept = flag.Bool([]string{"-delete-concept"}, false, "Removes the concept definition when nothing else uses it. This is used with --inline-concept")
+var moveConcepts = flag.String([]string{"-move-concepts"}, "", "Moves the given concepts, with their comments, to a concept file. Eg: gauge --move-concepts specs/login.cpt \"log in as <user>\" \"log out\"")
+var splitConcepts = flag.String([]string{"-split-concepts"}, "", "Splits a concept file into files named after the first word of each concept heading, or after the only spec using each concept. Eg: gauge --split-concepts specs/all.cpt --split-concepts-by usage")
+var splitConceptsBy = flag.String([]string{"-split-concepts-by"}, "prefix", "Splits concept files by prefix or usage. This is used with --split-concepts")
+var extractConcept = flag.String([]string{"-extract-concept"}, "", "Extracts the steps in the given lines of a spec into a concept, and replaces every occurrence of those steps with it. Eg: gauge --extract-concept specs/example.spec --extract-concept-lines 10-12 \"log in as <user>\"")
+var extractConceptLines = flag.String([]string{"-extract-concept-lines"}, "", "Lines of the steps to extract. This is used with --extract-concept")
+var conceptFile = flag.String([]string{"-concept-file"}, "", "Concept file to add the extracted concept to. Defaults to specs/concepts.cpt. This is used with --extract-concept")
+var undoRefactoring = flag.Bool([]string{"-refactor-undo"}, false, "Reverts the files changed by the last refactoring, if none of them has changed since. Eg: gauge --refactor-undo")
 var parallel = flag.Bool([]string{"-parallel", "p"}, false, "Execute specs in parallel")
 var numberOfExecutionStreams = flag.Int([]string{"n"}, util.NumberOfCores(), "Specify number of parallel execution streams")
 var distribute = flag.Int([]string{"g", "-group"}, -1, "Specify which group of specification to execute based on -n flag")
@@ -90,12 +109,22 @@ func main() {
 		} else {
 			logger.Error(err.Error())
 		}
+	} else if *specFilesToFormat == "-" {
+		formatter.FormatStdin(*stdinFileName, *formatLines, *formatEdits)
 	} else if *specFilesToFormat != "" {
 		if validGaugeProject {
 			formatter.FormatSpecFilesIn(*specFilesToFormat)
 		} else {
 			logger.Error(err.Error())
 		}
+	} else if *specFilesToCheckFormat != "" {
+		if validGaugeProject {
+			if !formatter.CheckFormatOfFilesIn(*specFilesToCheckFormat) {
+				os.Exit(1)
+			}
+		} else {
+			logger.Error(err.Error())
+		}
 	} else if *initialize != "" {
 		projectInit.InitializeProject(*initialize)
 	} else if *installZip != "" && *installPlugin != "" {
@@ -117,7 +146,84 @@ func main() {
 	} else if *refactorSteps != "" {
 		if validGaugeProject {
 			startChan := api.StartAPI()
-			refactor.RefactorSteps(*refactorSteps, newStepName(), startChan)
+			if *previewRefactoring {
+				refactor.PreviewRefactorSteps(*refactorSteps, newStepName(), startChan)
+			} else {
+				refactor.RefactorSteps(*refactorSteps, newStepName(), startChan)
+			}
+		} else {
+			logger.Error(err.Error())
+		}
+	} else if *refactorBatch != "" {
+		if validGaugeProject {
+			startChan := api.StartAPI()
+			if *previewRefactoring {
+				refactor.PreviewRefactorStepsFromFile(*refactorBatch, startChan)
+			} else {
+				refactor.RefactorStepsFromFile(*refactorBatch, startChan)
+			}
+		} else {
+			logger.Error(err.Error())
+		}
+	} else if *refactorTags != "" {
+		if validGaugeProject {
+			if *previewRefactoring {
+				refactor.PreviewRefactorTags(*refactorTags, newStepName())
+			} else {
+				refactor.RefactorTags(*refactorTags, newStepName())
+			}
+		} else {
+			logger.Error(err.Error())
+		}
+	} else if *inlineConcept != "" {
+		if validGaugeProject {
+			if *previewRefactoring {
+				refactor.PreviewInlineConcept(*inlineConcept, *conceptUsage, *deleteConcept)
+			} else {
+				refactor.RefactorInlineConcept(*inlineConcept, *conceptUsage, *deleteConcept)
+			}
+		} else {
+			logger.Error(err.Error())
+		}
+	} else if *extractConcept != "" {
+		if validGaugeProject {
+			if *previewRefactoring {
+				refactor.PreviewExtractConcept(*extractConcept, *extractConceptLines, newStepName(), *conceptFile)
+			} else {
+				refactor.RefactorExtractConcept(*extractConcept, *extractConceptLines, newStepName(), *conceptFile)
+			}
+		} else {
+			logger.Error(err.Error())
+		}
+	} else if *moveConcepts != "" {
+		if validGaugeProject {
+			if *previewRefactoring {
+				refactor.PreviewMoveConcepts(flag.Args(), *moveConcepts)
+			} else {
+				refactor.RefactorMoveConcepts(flag.Args(), *moveConcepts)
+			}
+		} else {
+			logger.Error(err.Error())
+		}
+	} else if *splitConcepts != "" {
+		if validGaugeProject {
+			if *previewRefactoring {
+				refactor.PreviewSplitConceptFile(*splitConcepts, *splitConceptsBy)
+			} else {
+				refactor.RefactorSplitConceptFile(*splitConcepts, *splitConceptsBy)
+			}
+		} else {
+			logger.Error(err.Error())
+		}
+	} else if *tagReport {
+		if validGaugeProject {
+			refactor.PrintTagReport(*executeTags)
+		} else {
+			logger.Error(err.Error())
+		}
+	} else if *undoRefactoring {
+		if validGaugeProject {
+			refactor.UndoRefactoring()
 		} else {
 			logger.Error(err.Error())
 		}
@@ -169,6 +275,7 @@ func initPackageFlags() {
 	execution.TableRows = *tableRows
 	execution.NumberOfExecutionStreams = *numberOfExecutionStreams
 	filter.ExecuteTags = *executeTags
+	filter.MetadataExpression = *where
 	filter.DoNotRandomize = *doNotRandomize
 	filter.Distribute = *distribute
 	filter.NumberOfExecutionStreams = *numberOfExecutionStreams
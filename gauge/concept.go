@@ -23,6 +23,8 @@ type Concept struct {
 	FileName string
 	LineNo   int
 	Heading  string
+	Value    string
+	Params   []string
 	Steps    []Step
 }
 
@@ -0,0 +1,46 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package gauge
+
+type Spec struct {
+	FileName      string
+	Heading       Heading
+	Tags          []string
+	Comments      []Comment
+	DataTable     *DataTable
+	Contexts      []Step
+	Scenarios     []Scenario
+	TearDownSteps []Step
+}
+
+type Scenario struct {
+	Heading  Heading
+	Tags     []string
+	Comments []Comment
+	Steps    []Step
+}
+
+type Heading struct {
+	LineNo int
+	Text   string
+}
+
+type Comment struct {
+	LineNo int
+	Text   string
+}
@@ -17,7 +17,26 @@
 
 package gauge
 
+type ArgType int
+
+const (
+	Static ArgType = iota
+	Dynamic
+	Special
+	TableArg
+)
+
 type Step struct {
 	LineNo     int
 	ActualText string
+	Value      string
+	Args       []StepArg
+}
+
+// StepArg is a parameter of a step. Value holds the literal of a static arg,
+// the name of a dynamic arg or the "type:value" of a special arg.
+type StepArg struct {
+	Type  ArgType
+	Value string
+	Table *Table
 }
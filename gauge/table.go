@@ -0,0 +1,37 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package gauge
+
+type Table struct {
+	LineNo  int
+	Headers []string
+	Rows    [][]Cell
+}
+
+// Cell is a table value. A Dynamic cell refers to a column of the data table.
+type Cell struct {
+	Value string
+	Type  ArgType
+}
+
+// DataTable drives the scenarios of a spec. Source is set when the table is
+// read from an external file instead of being written inline.
+type DataTable struct {
+	Table
+	Source string
+}
@@ -6,12 +6,14 @@
 Package gauge_messages is a generated protocol buffer package.
 
 It is generated from these files:
+
 	api.proto
 	api_v2.proto
 	messages.proto
 	spec.proto
 
 It has these top-level messages:
+
 	GetProjectRootRequest
 	GetProjectRootResponse
 	GetInstallationRootRequest
@@ -126,6 +128,10 @@ const (
 	APIMessage_FormatSpecsRequest               APIMessage_APIMessageType = 20
 	APIMessage_FormatSpecsResponse              APIMessage_APIMessageType = 21
 	APIMessage_UnsupportedApiMessageResponse    APIMessage_APIMessageType = 22
+	APIMessage_FormatTextRequest                APIMessage_APIMessageType = 23
+	APIMessage_FormatTextResponse               APIMessage_APIMessageType = 24
+	APIMessage_InlineConceptRequest             APIMessage_APIMessageType = 25
+	APIMessage_InlineConceptResponse            APIMessage_APIMessageType = 26
 )
 
 var APIMessage_APIMessageType_name = map[int32]string{
@@ -151,6 +157,10 @@ var APIMessage_APIMessageType_name = map[int32]string{
 	20: "FormatSpecsRequest",
 	21: "FormatSpecsResponse",
 	22: "UnsupportedApiMessageResponse",
+	23: "FormatTextRequest",
+	24: "FormatTextResponse",
+	25: "InlineConceptRequest",
+	26: "InlineConceptResponse",
 }
 var APIMessage_APIMessageType_value = map[string]int32{
 	"GetProjectRootRequest":            1,
@@ -175,6 +185,10 @@ var APIMessage_APIMessageType_value = map[string]int32{
 	"FormatSpecsRequest":               20,
 	"FormatSpecsResponse":              21,
 	"UnsupportedApiMessageResponse":    22,
+	"FormatTextRequest":                23,
+	"FormatTextResponse":               24,
+	"InlineConceptRequest":             25,
+	"InlineConceptResponse":            26,
 }
 
 func (x APIMessage_APIMessageType) Enum() *APIMessage_APIMessageType {
@@ -492,8 +506,10 @@ type PerformRefactoringRequest struct {
 	// / Step to refactor
 	OldStep *string `protobuf:"bytes,1,req,name=oldStep" json:"oldStep,omitempty"`
 	// / Change to be made
-	NewStep          *string `protobuf:"bytes,2,req,name=newStep" json:"newStep,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+	NewStep *string `protobuf:"bytes,2,req,name=newStep" json:"newStep,omitempty"`
+	// / Flag indicating that the changes should only be previewed, without saving them. Changes to step implementations are not previewed.
+	Preview          *bool  `protobuf:"varint,3,opt,name=preview" json:"preview,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *PerformRefactoringRequest) Reset()                    { *m = PerformRefactoringRequest{} }
@@ -515,6 +531,13 @@ func (m *PerformRefactoringRequest) GetNewStep() string {
 	return ""
 }
 
+func (m *PerformRefactoringRequest) GetPreview() bool {
+	if m != nil && m.Preview != nil {
+		return *m.Preview
+	}
+	return false
+}
+
 // / Response to PerformRefactoringRequest
 type PerformRefactoringResponse struct {
 	// / Flag indicating Success
@@ -522,8 +545,10 @@ type PerformRefactoringResponse struct {
 	// / Error message if the refactoring was unsuccessful.
 	Errors []string `protobuf:"bytes,2,rep,name=errors" json:"errors,omitempty"`
 	// / Collection of files that were changed as part of the Refactoring.
-	FilesChanged     []string `protobuf:"bytes,3,rep,name=filesChanged" json:"filesChanged,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+	FilesChanged []string `protobuf:"bytes,3,rep,name=filesChanged" json:"filesChanged,omitempty"`
+	// / Changes to each affected spec and concept file. Filled only for a preview.
+	FileChanges      []*FileChanges `protobuf:"bytes,4,rep,name=fileChanges" json:"fileChanges,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
 }
 
 func (m *PerformRefactoringResponse) Reset()                    { *m = PerformRefactoringResponse{} }
@@ -552,6 +577,13 @@ func (m *PerformRefactoringResponse) GetFilesChanged() []string {
 	return nil
 }
 
+func (m *PerformRefactoringResponse) GetFileChanges() []*FileChanges {
+	if m != nil {
+		return m.FileChanges
+	}
+	return nil
+}
+
 // / Request to perform Extract to Concept refactoring
 // / The runner does not do the refactoring here, instead it provides inputs enabling the IDE to do refactoring
 type ExtractConceptInfoRequest struct {
@@ -844,7 +876,15 @@ type APIMessage struct {
 	FormatSpecsResponse *FormatSpecsResponse `protobuf:"bytes,23,opt,name=formatSpecsResponse" json:"formatSpecsResponse,omitempty"`
 	// / [UnsupportedApiMessageResponse] (#gauge.messages.UnsupportedApiMessageResponse)
 	UnsupportedApiMessageResponse *UnsupportedApiMessageResponse `protobuf:"bytes,24,opt,name=unsupportedApiMessageResponse" json:"unsupportedApiMessageResponse,omitempty"`
-	XXX_unrecognized              []byte                         `json:"-"`
+	// / [FormatTextRequest] (#gauge.messages.FormatTextRequest)
+	FormatTextRequest *FormatTextRequest `protobuf:"bytes,25,opt,name=formatTextRequest" json:"formatTextRequest,omitempty"`
+	// / [FormatTextResponse] (#gauge.messages.FormatTextResponse)
+	FormatTextResponse *FormatTextResponse `protobuf:"bytes,26,opt,name=formatTextResponse" json:"formatTextResponse,omitempty"`
+	// / [InlineConceptRequest] (#gauge.messages.InlineConceptRequest)
+	InlineConceptRequest *InlineConceptRequest `protobuf:"bytes,27,opt,name=inlineConceptRequest" json:"inlineConceptRequest,omitempty"`
+	// / [InlineConceptResponse] (#gauge.messages.InlineConceptResponse)
+	InlineConceptResponse *InlineConceptResponse `protobuf:"bytes,28,opt,name=inlineConceptResponse" json:"inlineConceptResponse,omitempty"`
+	XXX_unrecognized      []byte                 `json:"-"`
 }
 
 func (m *APIMessage) Reset()                    { *m = APIMessage{} }
@@ -1020,6 +1060,228 @@ func (m *APIMessage) GetUnsupportedApiMessageResponse() *UnsupportedApiMessageRe
 	return nil
 }
 
+func (m *APIMessage) GetFormatTextRequest() *FormatTextRequest {
+	if m != nil {
+		return m.FormatTextRequest
+	}
+	return nil
+}
+
+func (m *APIMessage) GetFormatTextResponse() *FormatTextResponse {
+	if m != nil {
+		return m.FormatTextResponse
+	}
+	return nil
+}
+
+func (m *APIMessage) GetInlineConceptRequest() *InlineConceptRequest {
+	if m != nil {
+		return m.InlineConceptRequest
+	}
+	return nil
+}
+
+func (m *APIMessage) GetInlineConceptResponse() *InlineConceptResponse {
+	if m != nil {
+		return m.InlineConceptResponse
+	}
+	return nil
+}
+
+// / Request to format the contents of a spec or concept file which need not be saved
+type FormatTextRequest struct {
+	// / Contents to be formatted
+	Text *string `protobuf:"bytes,1,req,name=text" json:"text,omitempty"`
+	// / Name of the file the contents belong to. Files with .cpt extension are formatted as concepts, others as specs
+	FileName *string `protobuf:"bytes,2,opt,name=fileName" json:"fileName,omitempty"`
+	// / First line to be formatted. Formats from the beginning if not given
+	StartLine *int32 `protobuf:"varint,3,opt,name=startLine" json:"startLine,omitempty"`
+	// / Last line to be formatted. Formats till the end if not given
+	EndLine          *int32 `protobuf:"varint,4,opt,name=endLine" json:"endLine,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *FormatTextRequest) Reset()                    { *m = FormatTextRequest{} }
+func (m *FormatTextRequest) String() string            { return proto.CompactTextString(m) }
+func (*FormatTextRequest) ProtoMessage()               {}
+func (*FormatTextRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *FormatTextRequest) GetText() string {
+	if m != nil && m.Text != nil {
+		return *m.Text
+	}
+	return ""
+}
+
+func (m *FormatTextRequest) GetFileName() string {
+	if m != nil && m.FileName != nil {
+		return *m.FileName
+	}
+	return ""
+}
+
+func (m *FormatTextRequest) GetStartLine() int32 {
+	if m != nil && m.StartLine != nil {
+		return *m.StartLine
+	}
+	return 0
+}
+
+func (m *FormatTextRequest) GetEndLine() int32 {
+	if m != nil && m.EndLine != nil {
+		return *m.EndLine
+	}
+	return 0
+}
+
+// / A change to be made to the text of a file
+type TextEdit struct {
+	// / Range of the text to be replaced
+	Span *Span `protobuf:"bytes,1,req,name=span" json:"span,omitempty"`
+	// / Text to replace the range with
+	NewText          *string `protobuf:"bytes,2,req,name=newText" json:"newText,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *TextEdit) Reset()                    { *m = TextEdit{} }
+func (m *TextEdit) String() string            { return proto.CompactTextString(m) }
+func (*TextEdit) ProtoMessage()               {}
+func (*TextEdit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *TextEdit) GetSpan() *Span {
+	if m != nil {
+		return m.Span
+	}
+	return nil
+}
+
+func (m *TextEdit) GetNewText() string {
+	if m != nil && m.NewText != nil {
+		return *m.NewText
+	}
+	return ""
+}
+
+// / Response to FormatTextRequest
+type FormatTextResponse struct {
+	// / Formatted contents
+	FormattedText *string `protobuf:"bytes,1,opt,name=formattedText" json:"formattedText,omitempty"`
+	// / Changes which turn the given contents into the formatted contents
+	TextEdits []*TextEdit `protobuf:"bytes,2,rep,name=textEdits" json:"textEdits,omitempty"`
+	// / Parse errors, if any. The text is not formatted when there are errors
+	Errors []string `protobuf:"bytes,3,rep,name=errors" json:"errors,omitempty"`
+	// / Parse warnings, if any
+	Warnings         []string `protobuf:"bytes,4,rep,name=warnings" json:"warnings,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *FormatTextResponse) Reset()                    { *m = FormatTextResponse{} }
+func (m *FormatTextResponse) String() string            { return proto.CompactTextString(m) }
+func (*FormatTextResponse) ProtoMessage()               {}
+func (*FormatTextResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *FormatTextResponse) GetFormattedText() string {
+	if m != nil && m.FormattedText != nil {
+		return *m.FormattedText
+	}
+	return ""
+}
+
+func (m *FormatTextResponse) GetTextEdits() []*TextEdit {
+	if m != nil {
+		return m.TextEdits
+	}
+	return nil
+}
+
+func (m *FormatTextResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func (m *FormatTextResponse) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+// / Request to replace the usages of a concept by its steps
+type InlineConceptRequest struct {
+	// / The concept to be inlined, as written in its heading
+	ConceptName *string `protobuf:"bytes,1,req,name=conceptName" json:"conceptName,omitempty"`
+	// / Info related to the selected usage. All the usages are inlined if not given
+	SelectedTextInfo *TextInfo `protobuf:"bytes,2,opt,name=selectedTextInfo" json:"selectedTextInfo,omitempty"`
+	// / Flag indicating that the concept definition should be removed when nothing else uses it
+	DeleteConcept    *bool  `protobuf:"varint,3,opt,name=deleteConcept" json:"deleteConcept,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InlineConceptRequest) Reset()                    { *m = InlineConceptRequest{} }
+func (m *InlineConceptRequest) String() string            { return proto.CompactTextString(m) }
+func (*InlineConceptRequest) ProtoMessage()               {}
+func (*InlineConceptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *InlineConceptRequest) GetConceptName() string {
+	if m != nil && m.ConceptName != nil {
+		return *m.ConceptName
+	}
+	return ""
+}
+
+func (m *InlineConceptRequest) GetSelectedTextInfo() *TextInfo {
+	if m != nil {
+		return m.SelectedTextInfo
+	}
+	return nil
+}
+
+func (m *InlineConceptRequest) GetDeleteConcept() bool {
+	if m != nil && m.DeleteConcept != nil {
+		return *m.DeleteConcept
+	}
+	return false
+}
+
+// / Response to InlineConceptRequest
+type InlineConceptResponse struct {
+	// / Flag indicating Success
+	IsSuccess *bool `protobuf:"varint,1,req,name=isSuccess" json:"isSuccess,omitempty"`
+	// / Error message if the refactoring was unsuccessful.
+	Error *string `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+	// / Collection of files that were changed as part of the Refactoring.
+	FilesChanged     []string `protobuf:"bytes,3,rep,name=filesChanged" json:"filesChanged,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *InlineConceptResponse) Reset()                    { *m = InlineConceptResponse{} }
+func (m *InlineConceptResponse) String() string            { return proto.CompactTextString(m) }
+func (*InlineConceptResponse) ProtoMessage()               {}
+func (*InlineConceptResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *InlineConceptResponse) GetIsSuccess() bool {
+	if m != nil && m.IsSuccess != nil {
+		return *m.IsSuccess
+	}
+	return false
+}
+
+func (m *InlineConceptResponse) GetError() string {
+	if m != nil && m.Error != nil {
+		return *m.Error
+	}
+	return ""
+}
+
+func (m *InlineConceptResponse) GetFilesChanged() []string {
+	if m != nil {
+		return m.FilesChanged
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetProjectRootRequest)(nil), "gauge.messages.GetProjectRootRequest")
 	proto.RegisterType((*GetProjectRootResponse)(nil), "gauge.messages.GetProjectRootResponse")
@@ -1048,84 +1310,104 @@ func init() {
 	proto.RegisterType((*FormatSpecsResponse)(nil), "gauge.messages.FormatSpecsResponse")
 	proto.RegisterType((*UnsupportedApiMessageResponse)(nil), "gauge.messages.UnsupportedApiMessageResponse")
 	proto.RegisterType((*APIMessage)(nil), "gauge.messages.APIMessage")
+	proto.RegisterType((*FormatTextRequest)(nil), "gauge.messages.FormatTextRequest")
+	proto.RegisterType((*TextEdit)(nil), "gauge.messages.TextEdit")
+	proto.RegisterType((*FormatTextResponse)(nil), "gauge.messages.FormatTextResponse")
+	proto.RegisterType((*InlineConceptRequest)(nil), "gauge.messages.InlineConceptRequest")
+	proto.RegisterType((*InlineConceptResponse)(nil), "gauge.messages.InlineConceptResponse")
 	proto.RegisterEnum("gauge.messages.APIMessage_APIMessageType", APIMessage_APIMessageType_name, APIMessage_APIMessageType_value)
 }
 
 var fileDescriptor0 = []byte{
-	// 1185 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x57, 0x5d, 0x53, 0x1b, 0x37,
-	0x14, 0x1d, 0x30, 0x4e, 0xec, 0x6b, 0xb0, 0x85, 0x6c, 0x8c, 0x20, 0x05, 0xd2, 0x25, 0xed, 0x40,
-	0x5b, 0x28, 0xa5, 0x0f, 0x7d, 0xc8, 0x24, 0x29, 0x43, 0x03, 0xe3, 0x29, 0x61, 0x3c, 0x40, 0xfb,
-	0x2e, 0x8c, 0x6c, 0xb6, 0xb3, 0xec, 0x6e, 0x57, 0xeb, 0x49, 0xfa, 0x0b, 0xfb, 0xdc, 0xdf, 0xd3,
-	0x97, 0x5e, 0xc9, 0x5a, 0xb3, 0x1f, 0xda, 0x25, 0x7d, 0xb3, 0xaf, 0x74, 0xcf, 0x95, 0x8e, 0xce,
-	0xd5, 0xd1, 0x42, 0x93, 0x87, 0xee, 0x61, 0x18, 0x05, 0x71, 0x40, 0xdb, 0x13, 0x3e, 0x9d, 0x88,
-	0xc3, 0x07, 0x21, 0x25, 0x9f, 0x08, 0xb9, 0x09, 0x32, 0x14, 0xa3, 0xd9, 0x98, 0xb3, 0x0e, 0x6b,
-	0xe7, 0x22, 0x1e, 0x46, 0xc1, 0x1f, 0x62, 0x14, 0x5f, 0x05, 0x41, 0x7c, 0x25, 0xfe, 0x9c, 0x0a,
-	0x19, 0x3b, 0x07, 0xd0, 0xcf, 0x0f, 0xc8, 0x30, 0xf0, 0xa5, 0xa0, 0x5d, 0x68, 0x85, 0x8f, 0x61,
-	0xb6, 0xf0, 0x72, 0x71, 0xaf, 0xe9, 0x7c, 0x01, 0x9b, 0x38, 0x7d, 0xe0, 0xcb, 0x98, 0x7b, 0x1e,
-	0x8f, 0xdd, 0xc0, 0x4f, 0x83, 0xfd, 0x04, 0x2f, 0xac, 0xa3, 0x06, 0x91, 0x01, 0x71, 0x73, 0x63,
-	0x06, 0xb6, 0x07, 0x14, 0x13, 0x4f, 0x3c, 0xef, 0x3a, 0x16, 0xa1, 0x4c, 0xe0, 0xce, 0xa1, 0x9b,
-	0x89, 0x1a, 0x98, 0x23, 0x68, 0x70, 0x13, 0xc3, 0xf4, 0xda, 0x5e, 0xeb, 0x78, 0xfb, 0x30, 0xbb,
-	0xf5, 0xc3, 0xa1, 0xda, 0xb4, 0x9a, 0xf1, 0x3b, 0xf7, 0xa6, 0x22, 0x05, 0x8f, 0x8c, 0xcc, 0xe1,
-	0xdf, 0xcd, 0xe1, 0x67, 0x51, 0x03, 0xbf, 0x07, 0x75, 0x45, 0x5c, 0x82, 0xbd, 0x61, 0xc7, 0xc6,
-	0x19, 0x86, 0x54, 0x04, 0x38, 0x0d, 0xfc, 0x91, 0x08, 0xe3, 0xd4, 0xc2, 0xfb, 0xf9, 0x01, 0x03,
-	0x7e, 0x00, 0x8d, 0x91, 0x89, 0x19, 0xfc, 0x17, 0x79, 0x7c, 0x93, 0x33, 0xf0, 0xc7, 0x81, 0x33,
-	0x86, 0x56, 0xea, 0x2f, 0xfd, 0x01, 0x9a, 0x32, 0xd9, 0x94, 0x66, 0xee, 0xc9, 0xad, 0x53, 0x02,
-	0x8d, 0xb1, 0xeb, 0x89, 0x90, 0xc7, 0xf7, 0x6c, 0x51, 0x71, 0x4d, 0x29, 0x80, 0xe7, 0xfa, 0xe2,
-	0x72, 0xfa, 0x70, 0x2b, 0x22, 0x56, 0xc3, 0x58, 0xdd, 0x50, 0x31, 0xcf, 0x32, 0xfb, 0x50, 0xc9,
-	0xaa, 0xde, 0x8d, 0xf8, 0x64, 0x0e, 0x8a, 0xf6, 0xa1, 0x7d, 0xcf, 0xe5, 0xc0, 0x57, 0x08, 0x37,
-	0xfc, 0xd6, 0x13, 0x08, 0xba, 0xb0, 0xd7, 0x70, 0x06, 0xd0, 0xcb, 0x02, 0x98, 0xfd, 0xfe, 0xff,
-	0x15, 0x3b, 0x3f, 0xc2, 0x0e, 0x42, 0x5d, 0x70, 0x7f, 0x32, 0xc5, 0xe1, 0xa1, 0x37, 0x9d, 0xb8,
-	0xfe, 0x85, 0x7b, 0x3b, 0xc4, 0x1d, 0xa4, 0xd6, 0xe5, 0x99, 0x71, 0x23, 0xa0, 0x23, 0x78, 0x59,
-	0x9e, 0x64, 0xd6, 0xb2, 0x0c, 0x4b, 0x9a, 0x86, 0x59, 0xc6, 0x36, 0xac, 0xbc, 0x8f, 0xa2, 0x20,
-	0x9a, 0x0f, 0xaf, 0x40, 0x5d, 0xa8, 0x80, 0x19, 0x7f, 0x03, 0x1b, 0x43, 0x11, 0x8d, 0x83, 0xe8,
-	0xe1, 0x4a, 0x8c, 0xf9, 0x28, 0x0e, 0x22, 0xd7, 0x9f, 0x24, 0x0b, 0xe8, 0xc0, 0xf3, 0xc0, 0xbb,
-	0x53, 0x6b, 0x36, 0xbc, 0x60, 0xc0, 0x17, 0x1f, 0x75, 0x40, 0xb3, 0xec, 0x5c, 0xc3, 0xa6, 0x2d,
-	0xdd, 0xd4, 0xc2, 0xe9, 0x72, 0x3a, 0x1a, 0x21, 0x03, 0x3a, 0xbf, 0x41, 0xdb, 0xf0, 0x4c, 0x17,
-	0x97, 0x98, 0x5e, 0x43, 0xbc, 0x1e, 0x2c, 0xab, 0x63, 0x93, 0xa7, 0xf7, 0xb8, 0x25, 0x71, 0x87,
-	0xc7, 0x84, 0x51, 0x67, 0x1f, 0x36, 0xde, 0x7f, 0x8a, 0x23, 0x84, 0x4b, 0xa9, 0x22, 0x59, 0x13,
-	0x6e, 0x2f, 0x9e, 0x1f, 0x94, 0xf3, 0xcf, 0x02, 0xac, 0x65, 0xe7, 0x26, 0xf3, 0xf6, 0xa1, 0x65,
-	0x24, 0x78, 0xc9, 0x1f, 0x92, 0x43, 0xe9, 0xe5, 0x0f, 0x45, 0x9d, 0x1a, 0xdd, 0xc5, 0x56, 0xd0,
-	0x6d, 0xb6, 0xa8, 0xa5, 0x6a, 0x9f, 0xf4, 0x02, 0xba, 0x23, 0xbd, 0xca, 0x93, 0x51, 0x14, 0x48,
-	0x69, 0xae, 0x12, 0x2d, 0xac, 0x06, 0x5d, 0x87, 0x8e, 0x29, 0x76, 0x86, 0xdb, 0xd1, 0x05, 0x97,
-	0x34, 0x61, 0xc7, 0x40, 0xa4, 0xf0, 0x70, 0xa2, 0xb8, 0x53, 0xf2, 0x52, 0x1b, 0x61, 0x75, 0x94,
-	0x52, 0xeb, 0x98, 0xe5, 0xab, 0xc4, 0x66, 0x1c, 0xdb, 0xaa, 0x91, 0xfc, 0x4e, 0x74, 0x3d, 0xdf,
-	0x82, 0x96, 0x26, 0xde, 0x2d, 0x51, 0x8c, 0x3c, 0x5f, 0x28, 0x7d, 0x07, 0xfa, 0x24, 0xea, 0x74,
-	0x15, 0x9a, 0xc2, 0xbf, 0x33, 0xa1, 0x99, 0xdc, 0x5f, 0xc3, 0x92, 0x5e, 0x3a, 0x52, 0xe6, 0x3f,
-	0x02, 0xa0, 0x00, 0xe2, 0xb9, 0xa4, 0x35, 0x5e, 0xc8, 0x23, 0xfe, 0xa0, 0x65, 0xae, 0xeb, 0xd4,
-	0x54, 0xdc, 0x19, 0x42, 0x3f, 0x4f, 0xac, 0x39, 0x55, 0xac, 0xe4, 0xca, 0xeb, 0xcc, 0xb9, 0xce,
-	0x45, 0x35, 0xc3, 0xb4, 0x1f, 0xeb, 0x2e, 0xd0, 0x33, 0x14, 0x0a, 0x8f, 0xd3, 0xd7, 0x93, 0x4a,
-	0x7d, 0xbc, 0x87, 0x9a, 0x78, 0xb7, 0x76, 0x33, 0x93, 0x4c, 0xcd, 0x47, 0xe1, 0xe8, 0x69, 0x8a,
-	0x97, 0x8f, 0x3c, 0xf2, 0x91, 0x04, 0x23, 0x25, 0x67, 0x07, 0xb6, 0x7e, 0xf3, 0xe5, 0x34, 0x0c,
-	0x83, 0x08, 0xc9, 0x3e, 0x09, 0xdd, 0x0f, 0x33, 0x62, 0x13, 0x08, 0xe7, 0xef, 0x55, 0x80, 0x93,
-	0xe1, 0xc0, 0x84, 0xe9, 0x5b, 0x68, 0x19, 0xea, 0x6f, 0xfe, 0x0a, 0x67, 0xdc, 0xb4, 0x8f, 0xf7,
-	0xf3, 0x87, 0xf2, 0x98, 0x90, 0xfa, 0xa9, 0x12, 0x14, 0x0b, 0x66, 0xd6, 0xe0, 0x4e, 0x1f, 0x41,
-	0x8d, 0x9e, 0x00, 0x0d, 0x0b, 0xd6, 0xa3, 0xe9, 0x6c, 0x1d, 0x7f, 0x95, 0x47, 0xb6, 0xfa, 0x14,
-	0x3d, 0x85, 0x6e, 0x58, 0x34, 0x29, 0x14, 0x93, 0xc2, 0xf8, 0xfa, 0x29, 0x0c, 0x43, 0xd6, 0xaf,
-	0xb0, 0xee, 0xda, 0xad, 0xcb, 0x68, 0xef, 0x1b, 0x0b, 0x50, 0x89, 0xd9, 0xd1, 0x0f, 0xc0, 0xdc,
-	0x12, 0xa7, 0x63, 0xcf, 0x34, 0xda, 0xb7, 0x9f, 0x85, 0x66, 0xd6, 0xf6, 0x1a, 0x3a, 0x3c, 0xeb,
-	0x7f, 0xec, 0xb9, 0x46, 0x71, 0x2c, 0x28, 0x39, 0xa7, 0xa4, 0x6f, 0x80, 0xf0, 0x9c, 0x4d, 0xb2,
-	0x86, 0xce, 0xde, 0xad, 0xcc, 0xce, 0xd6, 0x4e, 0xa9, 0x8f, 0x35, 0x2b, 0x6b, 0xa7, 0x75, 0x6a,
-	0x6a, 0xa7, 0x55, 0xc9, 0xa0, 0xb2, 0x76, 0x46, 0xc0, 0x98, 0x2e, 0x73, 0xbe, 0xc3, 0x5a, 0xa5,
-	0xe9, 0x05, 0x8b, 0x7a, 0x07, 0xab, 0x32, 0xef, 0x3a, 0x6c, 0x59, 0xe7, 0xbf, 0xaa, 0xce, 0x37,
-	0xf5, 0xcf, 0xa1, 0xed, 0x65, 0xdc, 0x85, 0xad, 0xe8, 0xec, 0xef, 0x2d, 0xd9, 0x95, 0xa6, 0x34,
-	0x80, 0x8e, 0x97, 0x75, 0x1c, 0xd6, 0xd6, 0x48, 0x47, 0x9f, 0x8f, 0x64, 0xd6, 0xf4, 0x5d, 0x72,
-	0x6b, 0x74, 0x34, 0xc0, 0x56, 0x1e, 0x20, 0x6b, 0x5c, 0xd8, 0x5d, 0xbc, 0xf0, 0x06, 0x61, 0xa4,
-	0xb4, 0xbb, 0x8a, 0x0f, 0x16, 0xd5, 0x5d, 0xbc, 0xf8, 0x5a, 0x61, 0xab, 0xa5, 0xdd, 0x65, 0x7b,
-	0xdb, 0x5c, 0xc0, 0x46, 0x58, 0xe6, 0x98, 0x8c, 0x6a, 0xa8, 0xc2, 0x35, 0x52, 0x6e, 0xb1, 0x97,
-	0xb0, 0x19, 0x96, 0x1a, 0x28, 0xeb, 0xda, 0xdb, 0xb5, 0xc2, 0x72, 0x7f, 0x81, 0x35, 0x61, 0xf3,
-	0x43, 0xd6, 0xb3, 0x13, 0x65, 0x37, 0xcf, 0x33, 0xe8, 0x0b, 0xeb, 0xe5, 0xcf, 0xd6, 0xec, 0x5c,
-	0x95, 0x58, 0xc5, 0x5b, 0xa0, 0xe3, 0xc2, 0x95, 0xcf, 0xfa, 0xf6, 0xa6, 0xb3, 0x98, 0xc3, 0xcf,
-	0xd0, 0x1d, 0x17, 0xdd, 0x80, 0xad, 0xdb, 0x1b, 0xc7, 0x66, 0x1c, 0x37, 0xb0, 0x35, 0xad, 0xb2,
-	0x05, 0xc6, 0x34, 0xd6, 0x41, 0x1e, 0xab, 0xda, 0x4b, 0xfe, 0x5d, 0x82, 0x76, 0xce, 0x0f, 0x36,
-	0x4a, 0x3e, 0x3d, 0xc8, 0x02, 0xdd, 0x2c, 0xfb, 0xf8, 0x20, 0x8b, 0x74, 0xbb, 0xea, 0x4b, 0x83,
-	0xd4, 0xe8, 0x4e, 0xe5, 0xb7, 0x06, 0x59, 0x42, 0xff, 0xb6, 0x7c, 0x53, 0x90, 0x7a, 0x36, 0x3e,
-	0x9f, 0xff, 0x2c, 0x15, 0x4f, 0x11, 0x4d, 0x9e, 0xe3, 0x13, 0xc6, 0xf6, 0x99, 0x40, 0x1a, 0x66,
-	0x20, 0x7f, 0x23, 0x91, 0x26, 0x7e, 0xe7, 0x58, 0x1f, 0xc3, 0x04, 0xf0, 0x41, 0xf5, 0xd4, 0xdb,
-	0x96, 0xb4, 0xe8, 0xab, 0xa7, 0xdf, 0xb2, 0x64, 0x19, 0x6d, 0x36, 0xfb, 0x7e, 0x25, 0x2b, 0x86,
-	0xe9, 0x62, 0x7b, 0x93, 0xb6, 0x61, 0xda, 0xd2, 0xb5, 0xa4, 0x43, 0xb7, 0x2a, 0x5e, 0xba, 0x84,
-	0xa8, 0x83, 0x28, 0x6f, 0x2b, 0xb2, 0xaa, 0xaa, 0x5a, 0x7b, 0x85, 0x50, 0x55, 0xd5, 0xae, 0x7f,
-	0xd2, 0x55, 0x74, 0x17, 0x75, 0x4d, 0x7a, 0x8a, 0x55, 0x8b, 0x5c, 0xc9, 0x1a, 0xfd, 0xf2, 0x89,
-	0x77, 0x0c, 0xe9, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x90, 0x9e, 0x6a, 0xfb, 0x0d, 0x0f, 0x00,
-	0x00,
+	// 1434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x76, 0x1a, 0xb7,
+	0x13, 0x3f, 0x7c, 0xc5, 0x30, 0x18, 0x10, 0xe2, 0xc3, 0x02, 0xc7, 0x09, 0x59, 0xe7, 0xff, 0x3f,
+	0xa4, 0x69, 0x5c, 0xd7, 0xbd, 0xc8, 0x45, 0x9a, 0xa4, 0x34, 0x4d, 0x38, 0x9c, 0x3a, 0x2e, 0x27,
+	0x71, 0xdb, 0x6b, 0x05, 0x04, 0xde, 0x9e, 0xf5, 0xee, 0x76, 0x25, 0x92, 0xb4, 0xf7, 0x7d, 0xa2,
+	0x3e, 0x49, 0x9f, 0xa0, 0xaf, 0xd2, 0x23, 0xa1, 0x85, 0xfd, 0xd0, 0xe2, 0xb4, 0x77, 0xec, 0x48,
+	0xf3, 0x9b, 0xd1, 0x68, 0x66, 0x7e, 0x1a, 0xa0, 0x42, 0x7d, 0xfb, 0xc4, 0x0f, 0x3c, 0xe1, 0xe1,
+	0xfa, 0x92, 0xae, 0x96, 0xec, 0xe4, 0x9a, 0x71, 0x4e, 0x97, 0x8c, 0xf7, 0x81, 0xfb, 0x6c, 0xb6,
+	0x5e, 0xeb, 0xd7, 0x43, 0xe9, 0xfa, 0xdb, 0x3a, 0x80, 0xce, 0x98, 0x89, 0x69, 0xe0, 0xfd, 0xc2,
+	0x66, 0xe2, 0x8d, 0xe7, 0x89, 0x37, 0xec, 0xd7, 0x15, 0xe3, 0xc2, 0x7a, 0x04, 0xdd, 0xe4, 0x02,
+	0xf7, 0x3d, 0x97, 0x33, 0xdc, 0x82, 0xaa, 0xbf, 0x15, 0x93, 0xdc, 0x20, 0x3f, 0xac, 0x58, 0xb7,
+	0xa1, 0x3f, 0x66, 0x62, 0xe2, 0x72, 0x41, 0x1d, 0x87, 0x0a, 0xdb, 0x73, 0xa3, 0x60, 0x8f, 0xe1,
+	0xd0, 0xb8, 0xaa, 0x11, 0x09, 0x20, 0x3b, 0xb1, 0xa6, 0x61, 0xdb, 0x80, 0xc7, 0x4c, 0x8c, 0x1c,
+	0xe7, 0xad, 0x60, 0x3e, 0x0f, 0xe1, 0xc6, 0xd0, 0x8a, 0x49, 0x35, 0xcc, 0x29, 0x94, 0xa9, 0x96,
+	0x91, 0xdc, 0xa0, 0x30, 0xac, 0x9e, 0xdd, 0x39, 0x89, 0x87, 0xe2, 0x64, 0x2a, 0x0f, 0x2d, 0x77,
+	0xfc, 0x44, 0x9d, 0x15, 0x8b, 0xc0, 0xfb, 0x6c, 0xb6, 0x81, 0x7f, 0x0e, 0xad, 0x98, 0x54, 0xc3,
+	0x0f, 0xa1, 0x24, 0x03, 0x19, 0x62, 0xf7, 0xcc, 0xd8, 0x3e, 0x9b, 0xe9, 0xa0, 0x8e, 0x1c, 0xe7,
+	0x85, 0xe7, 0xce, 0x98, 0x2f, 0x22, 0x8e, 0x77, 0x93, 0x0b, 0x1a, 0xfc, 0x11, 0x94, 0x67, 0x5a,
+	0xa6, 0xf1, 0x0f, 0x93, 0xf8, 0x5a, 0x67, 0xe2, 0x2e, 0x3c, 0x6b, 0x01, 0xd5, 0xc8, 0x27, 0xfe,
+	0x12, 0x2a, 0x3c, 0x3c, 0x94, 0x8a, 0xdc, 0x8d, 0x47, 0xc7, 0x08, 0xca, 0x0b, 0xdb, 0x61, 0x3e,
+	0x15, 0x57, 0x24, 0x2f, 0x63, 0x8d, 0x31, 0x80, 0x63, 0xbb, 0xec, 0x62, 0x75, 0xfd, 0x8e, 0x05,
+	0xa4, 0x30, 0xc8, 0x0f, 0x4b, 0x3a, 0x14, 0x1b, 0x2d, 0x7d, 0x0e, 0xa9, 0x2c, 0xed, 0x5d, 0xb2,
+	0x8f, 0xfa, 0xa2, 0x70, 0x17, 0xea, 0x57, 0x94, 0x4f, 0x5c, 0x89, 0x70, 0x49, 0xdf, 0x39, 0x8c,
+	0xe4, 0x07, 0xb9, 0x61, 0xd9, 0x9a, 0x40, 0x3b, 0x0e, 0xa0, 0xcf, 0xfb, 0xef, 0x3d, 0xb6, 0xbe,
+	0x82, 0xbb, 0x63, 0x26, 0xce, 0xa9, 0xbb, 0x5c, 0xd1, 0x25, 0x9b, 0x3a, 0xab, 0xa5, 0xed, 0x9e,
+	0xdb, 0xef, 0xa6, 0x54, 0x5c, 0x45, 0xfc, 0x72, 0xf4, 0xba, 0x4e, 0xa0, 0x53, 0x18, 0x64, 0x2b,
+	0x69, 0x5f, 0xf6, 0xa1, 0xa8, 0xc2, 0xb0, 0xd6, 0xb8, 0x03, 0xb5, 0x97, 0x41, 0xe0, 0x05, 0x9b,
+	0xe5, 0x1a, 0x94, 0x98, 0x14, 0xe8, 0xf5, 0x0b, 0xe8, 0x4d, 0x59, 0xb0, 0xf0, 0x82, 0xeb, 0x37,
+	0x6c, 0x41, 0x67, 0xc2, 0x0b, 0x6c, 0x77, 0x19, 0x3a, 0xd0, 0x80, 0x3d, 0xcf, 0x99, 0x4b, 0x9f,
+	0x75, 0x5c, 0x1a, 0xb0, 0xe7, 0xb2, 0x0f, 0x4a, 0x90, 0x0f, 0x05, 0x7e, 0xc0, 0xde, 0xdb, 0xec,
+	0x03, 0x29, 0xa8, 0x08, 0xfd, 0x91, 0x83, 0xbe, 0x09, 0x50, 0x5b, 0x6f, 0xc0, 0x1e, 0x5f, 0xcd,
+	0x66, 0x8c, 0x73, 0x85, 0x58, 0xc6, 0x75, 0xb8, 0xa5, 0xdc, 0xe1, 0x24, 0x3f, 0x28, 0x0c, 0x2b,
+	0xb8, 0x0d, 0xfb, 0xf2, 0x22, 0xf9, 0x8b, 0x2b, 0xea, 0x2e, 0xd9, 0x9c, 0x14, 0x94, 0xf4, 0x14,
+	0xaa, 0x52, 0xba, 0x16, 0x72, 0x52, 0x34, 0xa7, 0xd4, 0xab, 0xed, 0x16, 0xeb, 0x01, 0xf4, 0x5e,
+	0x7e, 0x14, 0x01, 0x9d, 0x89, 0x48, 0x66, 0x85, 0xe7, 0xda, 0x87, 0xa2, 0xd8, 0x5c, 0xb6, 0xf5,
+	0x57, 0x0e, 0x3a, 0xf1, 0xbd, 0xe1, 0xbe, 0x07, 0x50, 0xd5, 0x69, 0x7c, 0x41, 0xaf, 0xc3, 0x8b,
+	0x6d, 0x27, 0xcd, 0xca, 0x9b, 0xc7, 0xc7, 0x50, 0xe2, 0xaa, 0x54, 0xf3, 0x83, 0x42, 0xe6, 0xa6,
+	0x43, 0x68, 0xcd, 0x94, 0x7f, 0xa3, 0x59, 0xe0, 0x71, 0xae, 0xdb, 0x91, 0x4a, 0xce, 0x32, 0x3e,
+	0x80, 0x86, 0x36, 0x26, 0xcf, 0xa1, 0x0c, 0x16, 0x55, 0x8c, 0xcf, 0x00, 0x71, 0xe6, 0xb0, 0x99,
+	0x60, 0x73, 0x99, 0xa2, 0xf2, 0x20, 0xa4, 0x34, 0xc8, 0x0d, 0xab, 0x67, 0x24, 0x69, 0x45, 0xe8,
+	0x75, 0x6b, 0x0c, 0xe5, 0xf0, 0x77, 0x58, 0x1b, 0x9b, 0x23, 0xa8, 0xf4, 0xe6, 0x82, 0x06, 0xc2,
+	0x76, 0x97, 0xe7, 0xb2, 0x46, 0x3c, 0x75, 0x9b, 0x25, 0xdc, 0x84, 0x0a, 0x73, 0xe7, 0x5a, 0xb4,
+	0x2e, 0x99, 0x27, 0x50, 0x54, 0xae, 0xef, 0x43, 0xd1, 0xdd, 0x02, 0xd4, 0xa0, 0x24, 0x36, 0x65,
+	0xa1, 0xf0, 0x7c, 0x1a, 0xd0, 0x6b, 0x55, 0x2a, 0xca, 0x8e, 0x4c, 0x86, 0x8a, 0x35, 0x85, 0x6e,
+	0x32, 0xb0, 0x3a, 0x0f, 0x9a, 0x50, 0xb1, 0xf9, 0xdb, 0x58, 0x26, 0x6c, 0x12, 0x73, 0x8d, 0x69,
+	0x4c, 0x04, 0xeb, 0x18, 0xf0, 0x2b, 0x2f, 0xb8, 0xa6, 0x22, 0xda, 0xe2, 0x70, 0x2d, 0xda, 0xcb,
+	0x2a, 0xd6, 0x63, 0x68, 0xc5, 0x36, 0x69, 0x9b, 0xdb, 0x54, 0x53, 0xdb, 0x64, 0x5c, 0x3e, 0xd0,
+	0xc0, 0xb5, 0xdd, 0xa5, 0x4e, 0x3e, 0xeb, 0x2e, 0x1c, 0xfd, 0xe8, 0xf2, 0x95, 0xef, 0x7b, 0x81,
+	0x60, 0xf3, 0x91, 0x6f, 0xbf, 0x5e, 0x07, 0x36, 0x84, 0xb0, 0xfe, 0x6c, 0x03, 0x8c, 0xa6, 0x13,
+	0x2d, 0xc6, 0xcf, 0xa0, 0xaa, 0x43, 0x7f, 0xf9, 0x9b, 0xbf, 0x8e, 0x4d, 0xfd, 0xec, 0x41, 0xf2,
+	0x52, 0xb6, 0x0a, 0x91, 0x9f, 0x52, 0x41, 0x46, 0x41, 0xef, 0x9a, 0xcc, 0xd5, 0x15, 0x14, 0xf0,
+	0x08, 0xb0, 0x9f, 0xa2, 0x2f, 0x15, 0xce, 0xea, 0xd9, 0xff, 0x92, 0xc8, 0x46, 0xae, 0xc3, 0x2f,
+	0xa0, 0xe5, 0xa7, 0x89, 0x8e, 0x14, 0x15, 0xc6, 0xff, 0x6f, 0xc2, 0xd0, 0xc1, 0xfa, 0x1e, 0x0e,
+	0x6c, 0x33, 0xfd, 0xe9, 0xdc, 0xfb, 0xcc, 0x00, 0x94, 0x41, 0x98, 0xf8, 0x35, 0x10, 0x3b, 0x83,
+	0x2d, 0xc9, 0x2d, 0x85, 0xf6, 0xf0, 0x93, 0xd0, 0xb4, 0x6f, 0x4f, 0xa0, 0x41, 0xe3, 0x1c, 0x4a,
+	0xf6, 0x14, 0x8a, 0x65, 0x40, 0x49, 0xb0, 0x2d, 0x7e, 0x0a, 0x88, 0x26, 0xa8, 0x96, 0x94, 0x95,
+	0xf6, 0xf1, 0x4e, 0xed, 0xb8, 0xed, 0x48, 0xf6, 0x91, 0xca, 0x4e, 0xdb, 0xd1, 0x3c, 0xd5, 0xb6,
+	0xa3, 0x59, 0x49, 0x60, 0xa7, 0xed, 0x58, 0x02, 0x3f, 0x05, 0xc4, 0x13, 0xdc, 0x45, 0xaa, 0x99,
+	0xea, 0x29, 0x9a, 0x7b, 0x0e, 0x4d, 0x9e, 0x64, 0x2e, 0xb2, 0xaf, 0xf4, 0xef, 0xef, 0xd6, 0xd7,
+	0xf6, 0xc7, 0x50, 0x77, 0x62, 0x0c, 0x45, 0x6a, 0x4a, 0xfb, 0x0b, 0x83, 0xf6, 0x4e, 0x62, 0x9b,
+	0x40, 0xc3, 0x89, 0xb3, 0x16, 0xa9, 0x2b, 0xa4, 0xd3, 0x4f, 0x47, 0xd2, 0x3e, 0x7d, 0x1e, 0x76,
+	0x8d, 0x86, 0x02, 0x38, 0x4a, 0x02, 0xc4, 0xc9, 0x6f, 0x04, 0x98, 0xa6, 0xde, 0x31, 0x04, 0x65,
+	0x56, 0x57, 0xfa, 0xd1, 0x23, 0xab, 0x8b, 0xa6, 0x5f, 0x3c, 0xa4, 0x99, 0x59, 0x5d, 0xa6, 0xf7,
+	0xd1, 0x39, 0xf4, 0xfc, 0x2c, 0xd6, 0x25, 0x58, 0x41, 0xa5, 0xda, 0x48, 0x36, 0x4d, 0x5f, 0x40,
+	0xdf, 0xcf, 0xa4, 0x5c, 0xd2, 0x32, 0x97, 0xeb, 0x0e, 0x92, 0xfe, 0x0e, 0x3a, 0xcc, 0xc4, 0x87,
+	0xa4, 0x6d, 0x0e, 0x94, 0x99, 0x3c, 0x5f, 0x41, 0x97, 0x19, 0x9b, 0x3f, 0xe9, 0x98, 0x63, 0x95,
+	0x41, 0x15, 0xcf, 0x00, 0x2f, 0x52, 0x2d, 0x9f, 0x74, 0xcd, 0x45, 0x67, 0x20, 0x87, 0x6f, 0xa0,
+	0xb5, 0x48, 0xb3, 0x01, 0x39, 0x30, 0x17, 0x8e, 0x89, 0x38, 0x2e, 0xe1, 0x68, 0xb5, 0x8b, 0x16,
+	0x08, 0x51, 0x58, 0x8f, 0x92, 0x58, 0x3b, 0xb9, 0x04, 0x7f, 0x0d, 0xcd, 0xb5, 0x5f, 0x92, 0xd4,
+	0xc3, 0x63, 0xf5, 0x14, 0xd2, 0x3d, 0xb3, 0x57, 0x91, 0x8d, 0xdb, 0xa8, 0xac, 0x85, 0xda, 0x91,
+	0xfe, 0xae, 0xa8, 0x44, 0x77, 0xe2, 0x6f, 0xa1, 0x6d, 0xab, 0xe7, 0x6d, 0xe2, 0x8a, 0x0f, 0xcd,
+	0xfd, 0x60, 0x62, 0xd8, 0x2b, 0xf3, 0x24, 0x81, 0xa1, 0xdd, 0xb8, 0x6d, 0xce, 0x93, 0x89, 0x69,
+	0xb3, 0xf5, 0x77, 0x09, 0xea, 0x09, 0x5e, 0xec, 0x65, 0x8c, 0x71, 0x28, 0x87, 0xfb, 0x59, 0x83,
+	0x1c, 0xca, 0xe3, 0x3b, 0xbb, 0xa6, 0x36, 0x54, 0xc0, 0x77, 0x77, 0xce, 0x6d, 0xa8, 0x88, 0xbb,
+	0xa6, 0xf9, 0x0c, 0x95, 0xe2, 0xf2, 0xcd, 0xfe, 0x5b, 0x11, 0x79, 0x24, 0xe1, 0xd0, 0x1e, 0x3e,
+	0x30, 0x8e, 0x5c, 0xa8, 0xac, 0x17, 0x92, 0x9d, 0x19, 0x55, 0x30, 0x31, 0x0f, 0x16, 0x08, 0xf0,
+	0xf1, 0x8d, 0x73, 0x02, 0xaa, 0xe2, 0xfb, 0x37, 0xcf, 0x05, 0x68, 0x1f, 0x37, 0x13, 0xb3, 0x00,
+	0xaa, 0xe9, 0x48, 0xa7, 0xdb, 0x1c, 0xaa, 0xeb, 0x48, 0x1b, 0xba, 0x17, 0x6a, 0xe0, 0xa3, 0x1d,
+	0x53, 0x03, 0x42, 0xf2, 0x22, 0xb2, 0xdb, 0x0b, 0x6a, 0x4a, 0xab, 0xc6, 0x9e, 0x81, 0xb0, 0xb4,
+	0x6a, 0xee, 0x03, 0xa8, 0x25, 0xc3, 0x9d, 0xae, 0x6f, 0xd4, 0x96, 0x51, 0x35, 0x94, 0x2d, 0xea,
+	0xe0, 0x7b, 0x37, 0xbc, 0xe7, 0x50, 0x17, 0x77, 0xa0, 0x99, 0x2a, 0x2e, 0x74, 0xb0, 0x35, 0x15,
+	0x2d, 0x1a, 0x44, 0xe4, 0x3d, 0x99, 0x4a, 0x01, 0xf5, 0xe4, 0x99, 0x8c, 0xf9, 0x8d, 0xfa, 0xd6,
+	0xcf, 0x06, 0x1b, 0xf1, 0x19, 0x24, 0xf6, 0x46, 0x5f, 0xbf, 0x7f, 0x9b, 0x72, 0xa4, 0xa4, 0x81,
+	0x90, 0xaf, 0x71, 0xf5, 0xfe, 0x2b, 0xc9, 0xe1, 0x49, 0x3f, 0xcf, 0xd5, 0x63, 0x4e, 0xce, 0xb3,
+	0x65, 0x09, 0xf9, 0x72, 0x6e, 0x0b, 0x6c, 0x41, 0x91, 0xfb, 0xd4, 0xcd, 0x1a, 0x52, 0xde, 0xfa,
+	0xd4, 0xd5, 0xe3, 0x9b, 0x54, 0x59, 0x8f, 0x6f, 0xd6, 0xef, 0xa6, 0x63, 0xe2, 0x0e, 0xd4, 0xd6,
+	0xbd, 0x45, 0x4f, 0x1c, 0x24, 0xa7, 0x3c, 0x7a, 0x08, 0x15, 0xa1, 0xad, 0x85, 0x63, 0x4e, 0x6a,
+	0x00, 0xd9, 0xb8, 0xb3, 0x7d, 0x6c, 0x17, 0x52, 0x8f, 0x6d, 0x39, 0xbe, 0x55, 0xac, 0xf7, 0xe6,
+	0x50, 0xca, 0x3f, 0x64, 0x92, 0x43, 0x97, 0x79, 0x06, 0xca, 0x9b, 0x67, 0xa0, 0x70, 0x5d, 0x1e,
+	0x63, 0xce, 0x1c, 0x26, 0x42, 0x03, 0x7a, 0x42, 0xfd, 0x21, 0xe3, 0xa2, 0xfe, 0xeb, 0x4c, 0xf2,
+	0xcf, 0x00, 0x89, 0x22, 0xf0, 0xc7, 0xac, 0x12, 0x00, 0x00,
 }
@@ -707,8 +707,10 @@ type RefactorRequest struct {
 	// / New value, the to-be value of Step being refactored.
 	NewStepValue *ProtoStepValue `protobuf:"bytes,2,req,name=newStepValue" json:"newStepValue,omitempty"`
 	// / Holds parameter positions of all parameters. Contains old and new parameter positions.
-	ParamPositions   []*ParameterPosition `protobuf:"bytes,3,rep,name=paramPositions" json:"paramPositions,omitempty"`
-	XXX_unrecognized []byte               `json:"-"`
+	ParamPositions []*ParameterPosition `protobuf:"bytes,3,rep,name=paramPositions" json:"paramPositions,omitempty"`
+	// / Flag indicating that the changes should only be returned in fileChanges, without saving them.
+	DryRun           *bool  `protobuf:"varint,4,opt,name=dryRun" json:"dryRun,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *RefactorRequest) Reset()                    { *m = RefactorRequest{} }
@@ -737,6 +739,13 @@ func (m *RefactorRequest) GetParamPositions() []*ParameterPosition {
 	return nil
 }
 
+func (m *RefactorRequest) GetDryRun() bool {
+	if m != nil && m.DryRun != nil {
+		return *m.DryRun
+	}
+	return false
+}
+
 // / Response of a RefactorRequest
 type RefactorResponse struct {
 	// / Flag indicating the success of Refactor operation.
@@ -744,8 +753,10 @@ type RefactorResponse struct {
 	// / Error message, valid only if Refactor wasn't successful
 	Error *string `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
 	// / List of files that were affected because of the refactoring.
-	FilesChanged     []string `protobuf:"bytes,3,rep,name=filesChanged" json:"filesChanged,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+	FilesChanged []string `protobuf:"bytes,3,rep,name=filesChanged" json:"filesChanged,omitempty"`
+	// / Contents of the affected files after refactoring. Filled only for a dry run.
+	FileChanges      []*FileChanges `protobuf:"bytes,4,rep,name=fileChanges" json:"fileChanges,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
 }
 
 func (m *RefactorResponse) Reset()                    { *m = RefactorResponse{} }
@@ -774,6 +785,13 @@ func (m *RefactorResponse) GetFilesChanged() []string {
 	return nil
 }
 
+func (m *RefactorResponse) GetFileChanges() []*FileChanges {
+	if m != nil {
+		return m.FileChanges
+	}
+	return nil
+}
+
 // / Request for details on a Single Step.
 type StepNameRequest struct {
 	// / Step text to lookup the Step.
@@ -1095,6 +1113,43 @@ func (m *Message) GetUnsupportedMessageResponse() *UnsupportedMessageResponse {
 	return nil
 }
 
+// / Changes made to a file by refactoring
+type FileChanges struct {
+	// / Name of the file
+	FileName *string `protobuf:"bytes,1,req,name=fileName" json:"fileName,omitempty"`
+	// / Contents of the file after the changes
+	FileContent *string `protobuf:"bytes,2,opt,name=fileContent" json:"fileContent,omitempty"`
+	// / Changes to the file as a unified diff
+	Diff             *string `protobuf:"bytes,3,opt,name=diff" json:"diff,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *FileChanges) Reset()                    { *m = FileChanges{} }
+func (m *FileChanges) String() string            { return proto.CompactTextString(m) }
+func (*FileChanges) ProtoMessage()               {}
+func (*FileChanges) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{30} }
+
+func (m *FileChanges) GetFileName() string {
+	if m != nil && m.FileName != nil {
+		return *m.FileName
+	}
+	return ""
+}
+
+func (m *FileChanges) GetFileContent() string {
+	if m != nil && m.FileContent != nil {
+		return *m.FileContent
+	}
+	return ""
+}
+
+func (m *FileChanges) GetDiff() string {
+	if m != nil && m.Diff != nil {
+		return *m.Diff
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*KillProcessRequest)(nil), "gauge.messages.KillProcessRequest")
 	proto.RegisterType((*ExecutionStatusResponse)(nil), "gauge.messages.ExecutionStatusResponse")
@@ -1126,95 +1181,100 @@ func init() {
 	proto.RegisterType((*StepNameResponse)(nil), "gauge.messages.StepNameResponse")
 	proto.RegisterType((*UnsupportedMessageResponse)(nil), "gauge.messages.UnsupportedMessageResponse")
 	proto.RegisterType((*Message)(nil), "gauge.messages.Message")
+	proto.RegisterType((*FileChanges)(nil), "gauge.messages.FileChanges")
 	proto.RegisterEnum("gauge.messages.StepValidateResponse_ErrorType", StepValidateResponse_ErrorType_name, StepValidateResponse_ErrorType_value)
 	proto.RegisterEnum("gauge.messages.Message_MessageType", Message_MessageType_name, Message_MessageType_value)
 }
 
 var fileDescriptor2 = []byte{
-	// 1359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x58, 0x6f, 0x6f, 0xdb, 0xb6,
-	0x13, 0x6e, 0x9c, 0xa4, 0xb1, 0x4f, 0x89, 0x4d, 0xd3, 0x4e, 0xcc, 0xb8, 0x6d, 0x7e, 0xa9, 0x5a,
-	0xfc, 0xd6, 0x0d, 0xab, 0x51, 0x18, 0x1b, 0xd0, 0x6d, 0xed, 0xb0, 0xae, 0x75, 0xd1, 0x74, 0x8d,
-	0x63, 0xd4, 0x6e, 0xf7, 0x0f, 0x43, 0xa0, 0xd9, 0x8c, 0x2b, 0xd4, 0x91, 0x3c, 0x51, 0xc2, 0xba,
-	0xef, 0xb0, 0x0f, 0xb3, 0x97, 0xfb, 0x32, 0xfb, 0x2a, 0xc3, 0x48, 0x9a, 0x92, 0x2d, 0x89, 0x54,
-	0xfb, 0x22, 0x79, 0x15, 0x98, 0xbc, 0x7b, 0xee, 0x78, 0x3c, 0x3e, 0xcf, 0x29, 0x50, 0x3d, 0xa7,
-	0x8c, 0x39, 0x53, 0xca, 0x3a, 0xf3, 0xc0, 0x0f, 0x7d, 0x5c, 0x9d, 0x3a, 0xd1, 0x94, 0x76, 0xe2,
-	0xd5, 0x36, 0xb0, 0x39, 0x1d, 0x2f, 0xf6, 0xec, 0x26, 0xe0, 0xef, 0xdc, 0xd9, 0x6c, 0x10, 0xf8,
-	0x63, 0xbe, 0xfd, 0x92, 0xfe, 0x16, 0x51, 0x16, 0xda, 0x3f, 0x40, 0xab, 0xf7, 0x8e, 0x8e, 0xa3,
-	0xd0, 0xf5, 0xbd, 0x61, 0xe8, 0x84, 0x11, 0xdf, 0x61, 0x73, 0xdf, 0x63, 0x14, 0x3f, 0x84, 0x1a,
-	0x8d, 0xb7, 0xf8, 0x62, 0x34, 0x0b, 0xc9, 0xda, 0x61, 0xe9, 0x8e, 0xd5, 0xbd, 0xdd, 0x49, 0x87,
-	0xe9, 0x0c, 0x44, 0x80, 0x5e, 0xda, 0xd6, 0xfe, 0x1e, 0xc8, 0x2a, 0x72, 0x10, 0xba, 0xde, 0x54,
-	0x45, 0xc5, 0x5f, 0x41, 0x73, 0x1c, 0x05, 0x01, 0xf5, 0xc2, 0xc4, 0xe4, 0xc8, 0x3b, 0xf3, 0x39,
-	0xfe, 0x1a, 0xc7, 0xbf, 0x91, 0xc5, 0x4f, 0x19, 0xd9, 0xaf, 0x60, 0x2f, 0x59, 0xe8, 0x79, 0x93,
-	0x8b, 0x82, 0xfd, 0x19, 0xae, 0x0f, 0x79, 0xb5, 0x2e, 0x27, 0xe7, 0x1f, 0xa1, 0x9d, 0x02, 0xbf,
-	0xc0, 0xbc, 0x4f, 0xe1, 0x70, 0x38, 0xa6, 0x9e, 0x13, 0xb8, 0xfe, 0xe5, 0xe4, 0xfe, 0x0b, 0x1c,
-	0xe4, 0x02, 0x5c, 0x70, 0xdd, 0x43, 0x3a, 0xbf, 0xbc, 0xba, 0xaf, 0x82, 0x5f, 0x60, 0xde, 0x7f,
-	0xaf, 0xc1, 0x4e, 0x6a, 0x05, 0xdf, 0x05, 0x4b, 0xc1, 0x89, 0xbb, 0x56, 0x28, 0x24, 0x8b, 0x22,
-	0xf6, 0xa4, 0xf9, 0xe7, 0x50, 0x8b, 0xcd, 0x55, 0x79, 0x49, 0x49, 0xba, 0x5c, 0xcf, 0xb9, 0xa8,
-	0xfd, 0x6c, 0x14, 0x7e, 0x32, 0xb2, 0x6e, 0x88, 0xc2, 0xf7, 0xa4, 0x39, 0x06, 0x60, 0xa1, 0x33,
-	0x7e, 0x1b, 0x06, 0xce, 0x98, 0x92, 0x0d, 0x6e, 0x5d, 0xb1, 0x9f, 0x43, 0x39, 0xc9, 0x62, 0x1b,
-	0x36, 0x3c, 0xe7, 0x9c, 0xca, 0xa7, 0x5d, 0xc1, 0x08, 0xca, 0x67, 0xee, 0x8c, 0xf6, 0xc5, 0x4a,
-	0x29, 0x5e, 0x71, 0xd9, 0x53, 0x87, 0x2f, 0x4e, 0x78, 0xac, 0xd2, 0x9d, 0xb2, 0xf0, 0x08, 0x9d,
-	0x29, 0xe3, 0x58, 0xeb, 0x1c, 0xeb, 0x01, 0x6c, 0xa7, 0xd2, 0xcb, 0xe1, 0x25, 0xde, 0xa5, 0x94,
-	0xf7, 0xba, 0xf4, 0xee, 0xf3, 0x4c, 0xe2, 0x4c, 0xef, 0xc1, 0x06, 0x13, 0x27, 0x5a, 0x90, 0x8c,
-	0xad, 0xaf, 0x3e, 0x15, 0xe6, 0xf1, 0xfd, 0xe5, 0xd0, 0xed, 0x3f, 0xd7, 0x00, 0x6b, 0x0c, 0xf7,
-	0xa0, 0xea, 0x8c, 0xc3, 0xc8, 0x99, 0x89, 0xc5, 0x11, 0x7d, 0x17, 0xaa, 0xf4, 0xf8, 0xfa, 0xdc,
-	0x09, 0x18, 0x9d, 0x24, 0xeb, 0x8b, 0x43, 0xb7, 0xa0, 0xc6, 0xd4, 0xa1, 0x04, 0x3c, 0x6f, 0x19,
-	0x59, 0xe7, 0x32, 0x2f, 0x3e, 0x70, 0x07, 0x7e, 0xbe, 0x90, 0x06, 0x8b, 0x0a, 0x58, 0xdd, 0xfd,
-	0x1c, 0x1d, 0xc6, 0x16, 0xf6, 0x63, 0x68, 0x08, 0xe4, 0xd7, 0xce, 0xcc, 0x9d, 0x38, 0x21, 0x5d,
-	0xc9, 0x9b, 0xa5, 0x13, 0x69, 0x03, 0xf6, 0xa2, 0xf3, 0x5f, 0x69, 0x70, 0x72, 0x36, 0x58, 0xe2,
-	0x8b, 0x64, 0x36, 0xed, 0xbf, 0xd6, 0xa0, 0x99, 0x46, 0x51, 0x04, 0x5d, 0x83, 0x2d, 0x97, 0xc9,
-	0x55, 0x89, 0x52, 0xc6, 0x4d, 0xd8, 0xa6, 0x41, 0xe0, 0x07, 0xc7, 0x8b, 0x4c, 0x64, 0x3b, 0x55,
-	0xf0, 0x23, 0xa8, 0xc8, 0xd5, 0xd1, 0x1f, 0x73, 0x2a, 0x8f, 0x51, 0xed, 0x76, 0x74, 0xed, 0x92,
-	0xc5, 0xef, 0xf4, 0x62, 0x2f, 0xbb, 0x03, 0x95, 0xe4, 0x07, 0xbe, 0x09, 0x37, 0x86, 0xa3, 0xde,
-	0xe0, 0xf4, 0xe8, 0x78, 0xf0, 0xa2, 0x77, 0xdc, 0xeb, 0x8f, 0x1e, 0x8d, 0x8e, 0x4e, 0xfa, 0xa7,
-	0xfd, 0x93, 0xd1, 0xe9, 0xd3, 0x93, 0x57, 0xfd, 0x27, 0xe8, 0x8a, 0x7d, 0xcc, 0x33, 0x8e, 0xdc,
-	0x90, 0x66, 0x34, 0x81, 0xb7, 0xbc, 0xc5, 0xc4, 0x7a, 0x4a, 0x4e, 0x0e, 0xb5, 0x72, 0x32, 0x5c,
-	0xda, 0xd9, 0x18, 0x90, 0x48, 0x50, 0x74, 0x65, 0x22, 0x5c, 0x36, 0xd4, 0x57, 0xd6, 0x54, 0x45,
-	0x76, 0x60, 0x53, 0x14, 0x96, 0x71, 0x64, 0xd1, 0x5d, 0x07, 0x9c, 0x5a, 0xd4, 0x35, 0x3e, 0x71,
-	0x42, 0x67, 0x18, 0xfa, 0x01, 0x3d, 0xf2, 0xdc, 0x30, 0xc6, 0x68, 0x03, 0x11, 0xef, 0x40, 0xbb,
-	0x77, 0x0d, 0xf6, 0x65, 0x0a, 0xda, 0xcd, 0x87, 0x50, 0x4f, 0xae, 0x69, 0xe0, 0x33, 0x57, 0x1c,
-	0x11, 0x37, 0xc0, 0xf2, 0x67, 0x93, 0xf8, 0xa7, 0x3c, 0xdc, 0xa6, 0x58, 0xf4, 0xe8, 0xef, 0xc9,
-	0xe2, 0xe2, 0x46, 0x39, 0x75, 0xd4, 0x5e, 0xd2, 0x33, 0xde, 0x92, 0x7e, 0x10, 0xf7, 0xc4, 0x67,
-	0xb0, 0xcd, 0xbd, 0xd5, 0x3d, 0x44, 0x54, 0xd5, 0xe6, 0x40, 0x5f, 0x9b, 0xd8, 0x4a, 0x78, 0x71,
-	0xf8, 0xa5, 0x57, 0xe9, 0x83, 0xbc, 0xbe, 0x90, 0x6d, 0xef, 0x9c, 0xc7, 0x69, 0x2d, 0x5e, 0xa3,
-	0xd5, 0xbd, 0x69, 0xec, 0xe4, 0xd8, 0xd2, 0x7e, 0x06, 0x68, 0x99, 0xf9, 0xb2, 0x0f, 0x59, 0x34,
-	0x16, 0x53, 0x85, 0xea, 0x43, 0x7e, 0x0d, 0xb2, 0xe3, 0x54, 0x03, 0xf2, 0xb6, 0x14, 0xa4, 0xc2,
-	0x1e, 0xbf, 0x71, 0xbc, 0xa9, 0xa4, 0x11, 0x71, 0x39, 0xb7, 0xa1, 0x16, 0x5f, 0x60, 0x5c, 0x83,
-	0x3a, 0x54, 0x58, 0xaa, 0x00, 0x15, 0xde, 0x49, 0x68, 0x69, 0xa5, 0xe2, 0xed, 0xc2, 0x8e, 0xcb,
-	0xc4, 0xea, 0x20, 0xa0, 0x8c, 0xf3, 0xa0, 0x8a, 0xaa, 0x5e, 0x95, 0xe2, 0xae, 0xf5, 0x05, 0xfb,
-	0xbc, 0x71, 0xd8, 0xa3, 0x99, 0xeb, 0xb0, 0x05, 0x77, 0xd9, 0x77, 0xa1, 0xfd, 0xca, 0x63, 0xd1,
-	0x7c, 0xee, 0x07, 0x21, 0x9d, 0xa8, 0x77, 0xb2, 0x7a, 0x10, 0x75, 0x74, 0x49, 0xde, 0x15, 0xfb,
-	0x5f, 0x0c, 0x5b, 0xca, 0x08, 0xdf, 0x07, 0x4b, 0x6d, 0xca, 0x87, 0x24, 0x62, 0x56, 0xbb, 0xb7,
-	0xb2, 0x15, 0x53, 0xd6, 0xf1, 0x5f, 0xf9, 0x60, 0xf8, 0xb1, 0xd4, 0xfe, 0xd1, 0x82, 0xa7, 0xd6,
-	0xf1, 0x73, 0x20, 0xd4, 0x20, 0x78, 0x8a, 0xd1, 0xef, 0x18, 0xd5, 0x27, 0x2b, 0x90, 0x2f, 0xe1,
-	0x3a, 0x2b, 0x18, 0x5c, 0x24, 0xe7, 0x5b, 0xdd, 0x4f, 0x75, 0x3a, 0x64, 0xc4, 0xec, 0x43, 0x9b,
-	0x19, 0xe7, 0x15, 0xb2, 0x29, 0x11, 0x3f, 0x29, 0x44, 0x4c, 0x2b, 0xed, 0x4f, 0x70, 0xc8, 0xde,
-	0x33, 0xa4, 0x90, 0xab, 0x12, 0xf5, 0x9e, 0x49, 0xfc, 0x8c, 0xb9, 0xbe, 0x86, 0x03, 0x56, 0x38,
-	0x9f, 0x90, 0x2d, 0x89, 0xdc, 0x79, 0x2f, 0x72, 0x3a, 0x67, 0x51, 0xd7, 0x82, 0xc1, 0x84, 0x94,
-	0x0d, 0x75, 0x2d, 0x1a, 0x66, 0x44, 0x5d, 0x8d, 0xf3, 0x08, 0xa9, 0x18, 0xea, 0x6a, 0x9e, 0x60,
-	0xbe, 0x06, 0x4c, 0x73, 0x72, 0x47, 0x40, 0xe2, 0x7c, 0x88, 0x82, 0x3e, 0x85, 0x3d, 0xaa, 0xcf,
-	0xc5, 0x92, 0x18, 0xff, 0x37, 0x76, 0x61, 0x3a, 0x8f, 0x6f, 0xa0, 0xc1, 0xf2, 0x42, 0x47, 0xb6,
-	0x25, 0xc8, 0xad, 0x62, 0xb5, 0x59, 0x20, 0x7c, 0x0b, 0x4d, 0xa6, 0x11, 0x21, 0xb2, 0x23, 0x21,
-	0x6e, 0x7f, 0x88, 0x60, 0xe1, 0x67, 0xd0, 0xa2, 0xfa, 0x8f, 0x19, 0x52, 0x95, 0x30, 0x1f, 0x15,
-	0x3d, 0xaa, 0xd5, 0x6f, 0x9f, 0x2f, 0x01, 0xb1, 0x8c, 0xe2, 0x90, 0x9a, 0x84, 0x38, 0xd4, 0x65,
-	0xb2, 0x6a, 0x87, 0x1f, 0x40, 0x9d, 0x65, 0x95, 0x89, 0x20, 0xe9, 0x7c, 0xb3, 0xc0, 0x59, 0x45,
-	0x16, 0x75, 0xd0, 0x48, 0x27, 0xa9, 0x1b, 0xea, 0xa0, 0x93, 0x59, 0xde, 0x15, 0x6f, 0x73, 0x9f,
-	0x7a, 0x04, 0xeb, 0xbb, 0x22, 0xff, 0x51, 0x28, 0x3b, 0xbf, 0x40, 0x37, 0x49, 0xc3, 0xd0, 0xf9,
-	0x05, 0x3e, 0x82, 0xf1, 0x98, 0x41, 0x6b, 0x49, 0x53, 0xcf, 0x78, 0x26, 0x6d, 0xc6, 0x2f, 0x60,
-	0x9f, 0x99, 0xb4, 0x99, 0xec, 0x4a, 0xb0, 0x8f, 0xb5, 0x85, 0xd2, 0xa2, 0xdd, 0xe7, 0xc3, 0x5e,
-	0x5a, 0x88, 0xc8, 0x9e, 0xc4, 0xf8, 0x9f, 0xe9, 0xb6, 0x62, 0xcf, 0x95, 0x2e, 0x49, 0x2e, 0xba,
-	0x55, 0xdc, 0x25, 0xc9, 0x3d, 0xf3, 0xa8, 0x41, 0x7a, 0x04, 0x20, 0x44, 0x1f, 0x35, 0x3b, 0x29,
-	0xf0, 0xa8, 0x41, 0x46, 0x82, 0xc9, 0xbe, 0x3e, 0x6a, 0x4e, 0xaa, 0x39, 0xff, 0x44, 0x46, 0xfd,
-	0x23, 0x6d, 0x3d, 0xff, 0x98, 0x15, 0xd3, 0xfe, 0x67, 0x03, 0xac, 0x55, 0xa9, 0xdb, 0x85, 0x7a,
-	0x8e, 0xfb, 0xd0, 0x15, 0xbc, 0x0f, 0xbb, 0x5a, 0xb9, 0x41, 0x6b, 0x7c, 0xd4, 0x6e, 0x68, 0x74,
-	0x03, 0x95, 0xf0, 0x0d, 0x3e, 0x80, 0x99, 0xa8, 0x1f, 0xad, 0xe3, 0x6b, 0xd0, 0x32, 0xf0, 0x37,
-	0xda, 0x90, 0xf1, 0x74, 0x34, 0x8c, 0x36, 0x65, 0xbc, 0x3c, 0x9f, 0xa2, 0xab, 0x5c, 0xfc, 0xad,
-	0x15, 0x82, 0x44, 0x5b, 0x7c, 0x74, 0xab, 0x65, 0xad, 0xca, 0xb1, 0x7b, 0x86, 0xbd, 0x50, 0x05,
-	0x13, 0xfd, 0x90, 0x8e, 0x40, 0x64, 0x6a, 0xa0, 0x19, 0x64, 0xf1, 0xd9, 0x28, 0x37, 0xda, 0xa2,
-	0x6d, 0x51, 0xc6, 0x1c, 0x33, 0xa0, 0x1d, 0xfe, 0xb9, 0xa2, 0xf9, 0x17, 0x0e, 0xaa, 0xca, 0xd8,
-	0x1a, 0x1e, 0x40, 0x35, 0x59, 0x08, 0xdd, 0xab, 0x44, 0x48, 0xc6, 0xc8, 0x3e, 0x30, 0x54, 0x17,
-	0x31, 0xf2, 0x4f, 0x05, 0x61, 0x51, 0x8d, 0x4c, 0xfb, 0xa3, 0xc6, 0x6a, 0xf6, 0x49, 0x9a, 0x4d,
-	0x61, 0x9a, 0xe9, 0x59, 0xb4, 0x2b, 0x4c, 0xb3, 0xdd, 0x88, 0xf6, 0xf0, 0x41, 0xd1, 0x3c, 0x86,
-	0x5a, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x2a, 0xa4, 0x15, 0xe1, 0x12, 0x00, 0x00,
+	// 1419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xed, 0x6e, 0x1a, 0x47,
+	0x17, 0xce, 0x1a, 0x1c, 0xb3, 0x67, 0x31, 0x0c, 0x03, 0x86, 0x31, 0x71, 0xfc, 0x92, 0x4d, 0xf4,
+	0x96, 0x56, 0x0d, 0x8a, 0x50, 0x23, 0xa5, 0x69, 0x52, 0x35, 0x1f, 0x58, 0x75, 0x1a, 0x63, 0x64,
+	0x9c, 0xf4, 0x4b, 0x95, 0xb5, 0x85, 0x31, 0x59, 0x05, 0xef, 0xd2, 0x9d, 0x59, 0x25, 0xe9, 0x35,
+	0xf4, 0x62, 0xfa, 0xb3, 0x97, 0xd0, 0x9b, 0xe8, 0xad, 0x54, 0xd5, 0xcc, 0x7e, 0xc0, 0xee, 0xce,
+	0x92, 0xfc, 0x70, 0x7e, 0x72, 0xe6, 0x9c, 0x67, 0x9e, 0x39, 0x73, 0xe6, 0x3c, 0x67, 0x81, 0xca,
+	0x05, 0x65, 0xcc, 0x9a, 0x51, 0xd6, 0x5b, 0x78, 0x2e, 0x77, 0x71, 0x65, 0x66, 0xf9, 0x33, 0xda,
+	0x8b, 0xac, 0x6d, 0x60, 0x0b, 0x3a, 0x09, 0xd6, 0xcc, 0x06, 0xe0, 0xef, 0xec, 0xf9, 0x7c, 0xe4,
+	0xb9, 0x13, 0xca, 0xd8, 0x09, 0xfd, 0xcd, 0xa7, 0x8c, 0x9b, 0x3f, 0x40, 0x6b, 0xf0, 0x96, 0x4e,
+	0x7c, 0x6e, 0xbb, 0xce, 0x98, 0x5b, 0xdc, 0x67, 0x27, 0x94, 0x2d, 0x5c, 0x87, 0x51, 0xfc, 0x10,
+	0xaa, 0x34, 0x5a, 0x3a, 0xa1, 0xcc, 0x9f, 0x73, 0xa2, 0x75, 0x36, 0xba, 0x46, 0xff, 0x56, 0x2f,
+	0xb9, 0x4d, 0x6f, 0x24, 0x36, 0x18, 0x24, 0x7d, 0xcd, 0xef, 0x81, 0xac, 0x22, 0x7b, 0xdc, 0x76,
+	0x66, 0xe1, 0xae, 0xf8, 0x2b, 0x68, 0x4c, 0x7c, 0xcf, 0xa3, 0x0e, 0x8f, 0x5d, 0x0e, 0x9d, 0x73,
+	0x97, 0x68, 0x1d, 0xad, 0x6b, 0xf4, 0xaf, 0xa7, 0xf1, 0x13, 0x4e, 0xe6, 0x0b, 0x68, 0xc6, 0x86,
+	0x81, 0x33, 0xbd, 0x2c, 0xd8, 0x9f, 0x61, 0x6f, 0xbc, 0xa0, 0x93, 0x8f, 0xc3, 0xf9, 0x47, 0x68,
+	0x27, 0xc0, 0x2f, 0x91, 0xf7, 0x19, 0x74, 0xc6, 0x13, 0xea, 0x58, 0x9e, 0xed, 0x7e, 0x1c, 0xee,
+	0xbf, 0xc0, 0x7e, 0x66, 0x83, 0x4b, 0xce, 0x3b, 0xa7, 0x8b, 0x8f, 0x97, 0xf7, 0x55, 0xf0, 0x4b,
+	0xe4, 0xfd, 0x97, 0x06, 0xdb, 0x09, 0x0b, 0xbe, 0x0d, 0x46, 0x08, 0x27, 0xee, 0x3a, 0x44, 0x21,
+	0x69, 0x14, 0xb1, 0x26, 0xdd, 0xef, 0x42, 0x35, 0x72, 0x0f, 0xd3, 0x4b, 0x36, 0x64, 0xc8, 0x5e,
+	0x26, 0x24, 0x5c, 0x4f, 0xef, 0xc2, 0xe9, 0x82, 0x14, 0x72, 0x76, 0xe1, 0x74, 0x21, 0xdd, 0x31,
+	0x00, 0xe3, 0xd6, 0xe4, 0x35, 0xf7, 0xac, 0x09, 0x25, 0xc5, 0x8e, 0xd6, 0xd5, 0xcd, 0x67, 0x50,
+	0x8a, 0x59, 0x94, 0xa1, 0xe8, 0x58, 0x17, 0x54, 0x3e, 0x6d, 0x1d, 0x23, 0x28, 0x9d, 0xdb, 0x73,
+	0x3a, 0x14, 0x96, 0x8d, 0xc8, 0x62, 0xb3, 0x03, 0xcb, 0x9e, 0xd3, 0x29, 0x29, 0x74, 0x36, 0xba,
+	0x25, 0x11, 0xc1, 0xad, 0x19, 0x23, 0xc5, 0x4e, 0xa1, 0xab, 0x9b, 0x0f, 0xa0, 0x9c, 0xa0, 0x97,
+	0xc1, 0x8b, 0xa3, 0x37, 0x12, 0xd1, 0x05, 0x19, 0x3d, 0x84, 0x52, 0xcc, 0xf4, 0x0e, 0x14, 0x99,
+	0x38, 0x51, 0xd0, 0x64, 0x4c, 0x75, 0xf6, 0xa9, 0x70, 0x8f, 0xee, 0x2f, 0x83, 0x6e, 0xfe, 0xa1,
+	0x01, 0x56, 0x38, 0x36, 0xa1, 0x62, 0x4d, 0xb8, 0x6f, 0xcd, 0x85, 0xf1, 0x94, 0xbe, 0xe5, 0x21,
+	0xbd, 0x26, 0x54, 0x16, 0x96, 0xc7, 0xe8, 0x34, 0xb6, 0x07, 0x87, 0x6e, 0x41, 0x95, 0x85, 0x87,
+	0x12, 0xf0, 0xb6, 0x33, 0x93, 0x79, 0x2e, 0xe1, 0xdb, 0x00, 0x0b, 0xcb, 0xb3, 0x2e, 0x28, 0xa7,
+	0x5e, 0x90, 0x01, 0xa3, 0xbf, 0x9b, 0x69, 0x87, 0x91, 0x87, 0xf9, 0x04, 0xea, 0x02, 0xf9, 0xa5,
+	0x35, 0xb7, 0xa7, 0x16, 0xa7, 0x2b, 0xbc, 0x59, 0x92, 0x48, 0x1b, 0xb0, 0xe3, 0x5f, 0xfc, 0x4a,
+	0xbd, 0xe3, 0xf3, 0xd1, 0x12, 0x5f, 0x90, 0xd9, 0x34, 0xff, 0xd4, 0xa0, 0x91, 0x44, 0x09, 0x1b,
+	0x74, 0x15, 0xb6, 0x6c, 0x26, 0xad, 0x12, 0xa5, 0x84, 0x1b, 0x50, 0xa6, 0x9e, 0xe7, 0x7a, 0x47,
+	0x01, 0x13, 0x59, 0x4e, 0x3a, 0x7e, 0x04, 0xba, 0xb4, 0x9e, 0xbe, 0x5b, 0x50, 0x79, 0x8c, 0x4a,
+	0xbf, 0xa7, 0x2a, 0x97, 0x34, 0x7e, 0x6f, 0x10, 0x45, 0x99, 0x3d, 0xd0, 0xe3, 0x1f, 0xf8, 0x06,
+	0x5c, 0x1f, 0x9f, 0x0e, 0x46, 0x67, 0x87, 0x47, 0xa3, 0xe7, 0x83, 0xa3, 0xc1, 0xf0, 0xf4, 0xd1,
+	0xe9, 0xe1, 0xf1, 0xf0, 0x6c, 0x78, 0x7c, 0x7a, 0x76, 0x70, 0xfc, 0x62, 0xf8, 0x14, 0x5d, 0x31,
+	0x8f, 0xa0, 0x31, 0xf6, 0x6d, 0x4e, 0x53, 0x9a, 0x80, 0xef, 0x82, 0xc1, 0x84, 0x3d, 0x21, 0x27,
+	0x1d, 0xa5, 0x9c, 0x8c, 0x97, 0x7e, 0x26, 0x06, 0x24, 0x08, 0x8a, 0xaa, 0x8c, 0x85, 0xcb, 0x84,
+	0xda, 0x8a, 0x2d, 0xcc, 0xc8, 0x36, 0x6c, 0x8a, 0xc4, 0x32, 0xa2, 0xc9, 0xea, 0xda, 0x87, 0xbd,
+	0xa8, 0x36, 0x9f, 0x5a, 0xdc, 0x1a, 0x73, 0xd7, 0xa3, 0x87, 0x8e, 0xcd, 0x23, 0x8c, 0x36, 0x10,
+	0xf1, 0x0e, 0x94, 0x6b, 0xd7, 0x60, 0x57, 0x52, 0x50, 0x2e, 0x3e, 0x84, 0x5a, 0x7c, 0x4d, 0x23,
+	0x97, 0xd9, 0xe2, 0x88, 0xb8, 0x0e, 0x86, 0x3b, 0x9f, 0x46, 0x3f, 0xe5, 0xe1, 0x36, 0x85, 0xd1,
+	0xa1, 0x6f, 0x62, 0x63, 0x70, 0xa3, 0x7f, 0x6b, 0x50, 0x3d, 0xa1, 0xe7, 0xd6, 0x84, 0xbb, 0x5e,
+	0x54, 0x13, 0x5f, 0x40, 0xd9, 0x9d, 0x4f, 0xc3, 0x7b, 0xf0, 0x69, 0x98, 0x9b, 0x7d, 0x75, 0x6e,
+	0x22, 0x2f, 0x11, 0xe5, 0xd0, 0x37, 0xcb, 0xa8, 0x8d, 0x0f, 0x8a, 0xfa, 0x52, 0x96, 0xbd, 0x75,
+	0x11, 0xd1, 0x0a, 0x5e, 0xa3, 0xd1, 0xbf, 0x91, 0x5b, 0xc9, 0xf1, 0x21, 0x2b, 0x70, 0x75, 0xea,
+	0xbd, 0x3b, 0xf1, 0x1d, 0xd9, 0x4a, 0x4a, 0xe6, 0xef, 0x80, 0x96, 0x27, 0x59, 0xd6, 0x25, 0xf3,
+	0x27, 0x13, 0xca, 0x58, 0x58, 0x97, 0xdb, 0xb0, 0x29, 0x2b, 0x30, 0x2c, 0xc8, 0x06, 0x94, 0x45,
+	0x93, 0x61, 0x4f, 0x5e, 0x59, 0xce, 0x4c, 0xb6, 0x95, 0x42, 0x57, 0xc7, 0x77, 0xc0, 0x10, 0xd6,
+	0xc0, 0x18, 0xbd, 0xad, 0x6b, 0x69, 0x46, 0x07, 0x4b, 0x17, 0xf3, 0x16, 0x54, 0xa3, 0x12, 0x88,
+	0xb2, 0x58, 0x03, 0x9d, 0x25, 0x52, 0xa8, 0x9b, 0x47, 0xcb, 0xe2, 0x89, 0x19, 0xee, 0xc0, 0xb6,
+	0xcd, 0x84, 0x75, 0xe4, 0x51, 0x46, 0x1d, 0x1e, 0xf2, 0x0c, 0xdf, 0x65, 0xd8, 0xfd, 0x0a, 0x41,
+	0xff, 0x7a, 0x65, 0xb1, 0x47, 0x73, 0xdb, 0x62, 0x41, 0xf7, 0x33, 0x6f, 0x43, 0xfb, 0x85, 0xc3,
+	0xfc, 0xc5, 0xc2, 0xf5, 0x38, 0x9d, 0x86, 0x2f, 0x6d, 0xf5, 0xe8, 0x21, 0x55, 0xd9, 0xfe, 0x75,
+	0xf3, 0x5f, 0x0c, 0x5b, 0xa1, 0x13, 0xbe, 0x07, 0x46, 0xb8, 0x28, 0x9f, 0xa2, 0xd8, 0xb3, 0xd2,
+	0xbf, 0x99, 0x3e, 0x61, 0xe8, 0xdd, 0x3b, 0x5a, 0xba, 0x8a, 0x63, 0x85, 0xeb, 0x87, 0x41, 0xa7,
+	0x2b, 0xe0, 0x67, 0x40, 0x68, 0x8e, 0x64, 0x86, 0x9a, 0xd0, 0xcd, 0xd5, 0xaf, 0x94, 0x3f, 0x3e,
+	0x81, 0x3d, 0xb6, 0x66, 0xf4, 0x91, 0x57, 0x6d, 0xf4, 0x3f, 0x57, 0x29, 0x59, 0x2e, 0xe6, 0x10,
+	0xda, 0x2c, 0x77, 0xe2, 0x21, 0x9b, 0x12, 0xf1, 0xb3, 0xb5, 0x88, 0x89, 0x08, 0xfc, 0x13, 0x74,
+	0xd8, 0x7b, 0xc6, 0x1c, 0x72, 0x55, 0xa2, 0xde, 0xc9, 0x93, 0xcf, 0x5c, 0xae, 0x2f, 0x61, 0x9f,
+	0xad, 0x9d, 0x70, 0xc8, 0x96, 0x44, 0xee, 0xbd, 0x17, 0x39, 0xc9, 0x59, 0xe4, 0x75, 0xcd, 0x68,
+	0x43, 0x4a, 0x39, 0x79, 0x5d, 0x13, 0x23, 0xf3, 0x9a, 0x3b, 0xd1, 0x10, 0x3d, 0x27, 0xaf, 0xb9,
+	0x11, 0xf8, 0x6b, 0xc0, 0x34, 0x23, 0x98, 0x04, 0x3a, 0xda, 0x07, 0x6a, 0xf0, 0x01, 0x34, 0xa9,
+	0x9a, 0x8b, 0x21, 0x31, 0xfe, 0x9f, 0x5b, 0x85, 0x49, 0x1e, 0xdf, 0x40, 0x9d, 0x65, 0xa5, 0x92,
+	0x94, 0x25, 0xc8, 0xcd, 0xf5, 0x7a, 0x15, 0x20, 0x3c, 0x86, 0x06, 0x53, 0xc8, 0x18, 0xd9, 0xee,
+	0x68, 0xaa, 0x8f, 0x16, 0xa5, 0xa4, 0x7e, 0x0b, 0x2d, 0xaa, 0xfe, 0x1c, 0x22, 0x15, 0x09, 0xf3,
+	0xc9, 0xba, 0x47, 0xb5, 0xe2, 0x8e, 0xef, 0x03, 0x62, 0x29, 0xcd, 0x22, 0xd5, 0x8e, 0xa6, 0xd2,
+	0xbb, 0xb4, 0xb6, 0xe1, 0x07, 0x50, 0x63, 0x69, 0x6d, 0x23, 0xa8, 0xa3, 0xa9, 0x5a, 0x74, 0x56,
+	0x04, 0x45, 0x1e, 0x14, 0xe2, 0x4b, 0x6a, 0x39, 0x79, 0x50, 0xf8, 0x8a, 0xaa, 0x78, 0x9d, 0xf9,
+	0x58, 0x24, 0x58, 0x5d, 0x15, 0xd9, 0xcf, 0x4a, 0x59, 0xf9, 0x6b, 0x94, 0x97, 0xd4, 0x73, 0x2a,
+	0x7f, 0x4d, 0x8c, 0xe8, 0x78, 0x2c, 0x47, 0xad, 0x49, 0x43, 0xdd, 0xf1, 0xf2, 0xd4, 0x1d, 0x3f,
+	0x87, 0x5d, 0x96, 0xa7, 0xee, 0x64, 0x47, 0x82, 0x7d, 0xaa, 0x4c, 0x94, 0x12, 0xed, 0x1e, 0x54,
+	0x59, 0x52, 0x88, 0x48, 0x53, 0x62, 0xfc, 0x2f, 0xef, 0xb6, 0xa2, 0xc8, 0x95, 0x2a, 0x89, 0x2f,
+	0xba, 0xb5, 0xbe, 0x4a, 0xe2, 0x7b, 0xbe, 0x07, 0x55, 0x2f, 0x39, 0x44, 0x10, 0xa2, 0xde, 0x35,
+	0x3d, 0x6b, 0xdc, 0x07, 0xe4, 0xa5, 0x44, 0x9b, 0xec, 0xaa, 0x77, 0xcd, 0x88, 0xfb, 0x10, 0xda,
+	0x7e, 0xae, 0xfe, 0x91, 0xb6, 0xba, 0xff, 0xe4, 0x2b, 0xa6, 0xf9, 0x4f, 0x11, 0x8c, 0x55, 0xa9,
+	0xdb, 0x81, 0x5a, 0xa6, 0xf7, 0xa1, 0x2b, 0x78, 0x17, 0x76, 0x94, 0x72, 0x83, 0x34, 0xdc, 0x82,
+	0xba, 0x42, 0x37, 0xd0, 0x06, 0xbe, 0x0e, 0xbb, 0xb9, 0xad, 0x1f, 0x15, 0xf0, 0x35, 0x68, 0xe5,
+	0xf4, 0x6f, 0x54, 0x94, 0xfb, 0xa9, 0xda, 0x30, 0xda, 0x94, 0xfb, 0x65, 0xfb, 0x29, 0xba, 0x8a,
+	0xab, 0x60, 0xac, 0x34, 0x48, 0xb4, 0x85, 0xeb, 0x50, 0x4d, 0x7b, 0x95, 0xa2, 0xf0, 0x54, 0xf7,
+	0x42, 0x3a, 0x26, 0xea, 0x31, 0x1f, 0x81, 0x60, 0x9a, 0xd3, 0x66, 0x90, 0x81, 0x1b, 0xd9, 0xe1,
+	0x18, 0x95, 0x45, 0x1a, 0x33, 0x9d, 0x01, 0x6d, 0xe3, 0xa6, 0xea, 0x4f, 0x20, 0x54, 0x91, 0x7b,
+	0x2b, 0xfa, 0x00, 0xaa, 0xca, 0x44, 0xa8, 0x5e, 0x25, 0x42, 0x72, 0x8f, 0xf4, 0x03, 0x43, 0x35,
+	0xb1, 0x47, 0xf6, 0xa9, 0x20, 0x2c, 0xb2, 0x91, 0x2a, 0x7f, 0x54, 0x5f, 0x65, 0x1f, 0xd3, 0x6c,
+	0x08, 0xd7, 0x54, 0xcd, 0xa2, 0x1d, 0xe1, 0x9a, 0xae, 0x46, 0xd4, 0xc4, 0xfb, 0xeb, 0xe6, 0x31,
+	0xd4, 0x32, 0x1f, 0x83, 0xb1, 0x32, 0x33, 0x26, 0x3e, 0x70, 0x83, 0x4f, 0xaf, 0x7a, 0x38, 0x77,
+	0xba, 0x0e, 0x17, 0x93, 0x60, 0x30, 0xa2, 0x96, 0xa1, 0x38, 0xb5, 0xcf, 0xcf, 0xe5, 0x24, 0xa5,
+	0xff, 0x37, 0x00, 0x8b, 0x92, 0x95, 0x42, 0x67, 0x13, 0x00, 0x00,
 }
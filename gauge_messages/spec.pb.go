@@ -194,8 +194,14 @@ type ProtoSpec struct {
 	// / Contains the filename for that holds this specification.
 	FileName *string `protobuf:"bytes,6,req,name=fileName" json:"fileName,omitempty"`
 	// / Contains a list of tags that are defined at the specification level. Scenario tags are not present here.
-	Tags             []string `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+	Tags []string `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty"`
+	// / Source position of the specification heading.
+	SpecHeadingSpan *Span `protobuf:"bytes,8,opt,name=specHeadingSpan" json:"specHeadingSpan,omitempty"`
+	// / Source positions of the specification tags, in the same order as tags.
+	TagSpans []*Span `protobuf:"bytes,9,rep,name=tagSpans" json:"tagSpans,omitempty"`
+	// / Metadata from the front-matter of the specification, in the order it is defined.
+	Metadata         []*SpecMetadata `protobuf:"bytes,10,rep,name=metadata" json:"metadata,omitempty"`
+	XXX_unrecognized []byte          `json:"-"`
 }
 
 func (m *ProtoSpec) Reset()                    { *m = ProtoSpec{} }
@@ -252,6 +258,27 @@ func (m *ProtoSpec) GetTags() []string {
 	return nil
 }
 
+func (m *ProtoSpec) GetSpecHeadingSpan() *Span {
+	if m != nil {
+		return m.SpecHeadingSpan
+	}
+	return nil
+}
+
+func (m *ProtoSpec) GetTagSpans() []*Span {
+	if m != nil {
+		return m.TagSpans
+	}
+	return nil
+}
+
+func (m *ProtoSpec) GetMetadata() []*SpecMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // / Container for all valid Items under a Specification.
 type ProtoItem struct {
 	// / Itemtype of the current ProtoItem
@@ -357,8 +384,12 @@ type ProtoScenario struct {
 	// / Holds the unique Identifier of a scenario.
 	ID *string `protobuf:"bytes,11,opt,name=ID" json:"ID,omitempty"`
 	// / Collection of Teardown steps. The Teardown steps are executed after every run.
-	TearDownSteps    []*ProtoItem `protobuf:"bytes,12,rep,name=tearDownSteps" json:"tearDownSteps,omitempty"`
-	XXX_unrecognized []byte       `json:"-"`
+	TearDownSteps []*ProtoItem `protobuf:"bytes,12,rep,name=tearDownSteps" json:"tearDownSteps,omitempty"`
+	// / Source position of the scenario heading.
+	ScenarioHeadingSpan *Span `protobuf:"bytes,13,opt,name=scenarioHeadingSpan" json:"scenarioHeadingSpan,omitempty"`
+	// / Source positions of the scenario tags, in the same order as tags.
+	TagSpans         []*Span `protobuf:"bytes,14,rep,name=tagSpans" json:"tagSpans,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *ProtoScenario) Reset()                    { *m = ProtoScenario{} }
@@ -450,6 +481,20 @@ func (m *ProtoScenario) GetTearDownSteps() []*ProtoItem {
 	return nil
 }
 
+func (m *ProtoScenario) GetScenarioHeadingSpan() *Span {
+	if m != nil {
+		return m.ScenarioHeadingSpan
+	}
+	return nil
+}
+
+func (m *ProtoScenario) GetTagSpans() []*Span {
+	if m != nil {
+		return m.TagSpans
+	}
+	return nil
+}
+
 // / A proto object representing a TableDrivenScenario
 type ProtoTableDrivenScenario struct {
 	// / Holds the Underlying scenario that is executed for every row in the table.
@@ -479,7 +524,9 @@ type ProtoStep struct {
 	Fragments []*Fragment `protobuf:"bytes,3,rep,name=fragments" json:"fragments,omitempty"`
 	// / Holds the result from the execution.
 	StepExecutionResult *ProtoStepExecutionResult `protobuf:"bytes,4,opt,name=stepExecutionResult" json:"stepExecutionResult,omitempty"`
-	XXX_unrecognized    []byte                    `json:"-"`
+	// / Source position of the Step text.
+	Span             *Span  `protobuf:"bytes,5,opt,name=span" json:"span,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *ProtoStep) Reset()                    { *m = ProtoStep{} }
@@ -515,6 +562,13 @@ func (m *ProtoStep) GetStepExecutionResult() *ProtoStepExecutionResult {
 	return nil
 }
 
+func (m *ProtoStep) GetSpan() *Span {
+	if m != nil {
+		return m.Span
+	}
+	return nil
+}
+
 // / Concept is a type of step, that can have multiple Steps.
 // / But from a caller's perspective, it is still used as any other Step
 // / A proto object representing a Concept
@@ -557,8 +611,12 @@ func (m *ProtoConcept) GetConceptExecutionResult() *ProtoStepExecutionResult {
 // / A proto object representing Tags
 type ProtoTags struct {
 	// / A collection of Tags
-	Tags             []string `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+	Tags []string `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty"`
+	// / Source positions of the Tags, in the same order as tags.
+	TagSpans []*Span `protobuf:"bytes,2,rep,name=tagSpans" json:"tagSpans,omitempty"`
+	// / Tags of the form key:value, split into their key and value.
+	KeyValueTags     []*KeyValueTag `protobuf:"bytes,3,rep,name=keyValueTags" json:"keyValueTags,omitempty"`
+	XXX_unrecognized []byte         `json:"-"`
 }
 
 func (m *ProtoTags) Reset()                    { *m = ProtoTags{} }
@@ -573,6 +631,20 @@ func (m *ProtoTags) GetTags() []string {
 	return nil
 }
 
+func (m *ProtoTags) GetTagSpans() []*Span {
+	if m != nil {
+		return m.TagSpans
+	}
+	return nil
+}
+
+func (m *ProtoTags) GetKeyValueTags() []*KeyValueTag {
+	if m != nil {
+		return m.KeyValueTags
+	}
+	return nil
+}
+
 // / A proto object representing Fragment.
 // / Fragments, put together make up A Step
 type Fragment struct {
@@ -620,8 +692,10 @@ type Parameter struct {
 	// / Holds the name of the parameter, used as Key to lookup the value.
 	Name *string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
 	// / Holds the table value, if parameterType=Table or Special_Table
-	Table            *ProtoTable `protobuf:"bytes,4,opt,name=table" json:"table,omitempty"`
-	XXX_unrecognized []byte      `json:"-"`
+	Table *ProtoTable `protobuf:"bytes,4,opt,name=table" json:"table,omitempty"`
+	// / Source position of the parameter in the Step text.
+	Span             *Span  `protobuf:"bytes,5,opt,name=span" json:"span,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Parameter) Reset()                    { *m = Parameter{} }
@@ -657,6 +731,13 @@ func (m *Parameter) GetTable() *ProtoTable {
 	return nil
 }
 
+func (m *Parameter) GetSpan() *Span {
+	if m != nil {
+		return m.Span
+	}
+	return nil
+}
+
 // / A proto object representing Comment.
 type ProtoComment struct {
 	// / Text representing the Comment.
@@ -707,8 +788,10 @@ func (m *ProtoTable) GetRows() []*ProtoTableRow {
 // / A proto object representing Table.
 type ProtoTableRow struct {
 	// / Represents the cells of a given table
-	Cells            []string `protobuf:"bytes,1,rep,name=cells" json:"cells,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+	Cells []string `protobuf:"bytes,1,rep,name=cells" json:"cells,omitempty"`
+	// / Source positions of the cells, in the same order as cells.
+	CellSpans        []*Span `protobuf:"bytes,2,rep,name=cellSpans" json:"cellSpans,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
 }
 
 func (m *ProtoTableRow) Reset()                    { *m = ProtoTableRow{} }
@@ -723,6 +806,13 @@ func (m *ProtoTableRow) GetCells() []string {
 	return nil
 }
 
+func (m *ProtoTableRow) GetCellSpans() []*Span {
+	if m != nil {
+		return m.CellSpans
+	}
+	return nil
+}
+
 // / A proto object representing Step Execution result
 type ProtoStepExecutionResult struct {
 	// / The actual result of the execution
@@ -1132,6 +1222,109 @@ func (m *ProtoStepValue) GetParameters() []string {
 	return nil
 }
 
+// / A proto object representing a range in a source file.
+// / Lines and columns are 1 based, columns count characters and endColumn is exclusive.
+type Span struct {
+	// / Line on which the range starts
+	StartLine *int32 `protobuf:"varint,1,req,name=startLine" json:"startLine,omitempty"`
+	// / Column at which the range starts
+	StartColumn *int32 `protobuf:"varint,2,req,name=startColumn" json:"startColumn,omitempty"`
+	// / Line on which the range ends
+	EndLine *int32 `protobuf:"varint,3,req,name=endLine" json:"endLine,omitempty"`
+	// / Column just after the end of the range
+	EndColumn        *int32 `protobuf:"varint,4,req,name=endColumn" json:"endColumn,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Span) Reset()                    { *m = Span{} }
+func (m *Span) String() string            { return proto.CompactTextString(m) }
+func (*Span) ProtoMessage()               {}
+func (*Span) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{18} }
+
+func (m *Span) GetStartLine() int32 {
+	if m != nil && m.StartLine != nil {
+		return *m.StartLine
+	}
+	return 0
+}
+
+func (m *Span) GetStartColumn() int32 {
+	if m != nil && m.StartColumn != nil {
+		return *m.StartColumn
+	}
+	return 0
+}
+
+func (m *Span) GetEndLine() int32 {
+	if m != nil && m.EndLine != nil {
+		return *m.EndLine
+	}
+	return 0
+}
+
+func (m *Span) GetEndColumn() int32 {
+	if m != nil && m.EndColumn != nil {
+		return *m.EndColumn
+	}
+	return 0
+}
+
+// / A proto object representing a tag of the form key:value.
+type KeyValueTag struct {
+	// / Key of the tag
+	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	// / Value of the tag
+	Value            *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *KeyValueTag) Reset()                    { *m = KeyValueTag{} }
+func (m *KeyValueTag) String() string            { return proto.CompactTextString(m) }
+func (*KeyValueTag) ProtoMessage()               {}
+func (*KeyValueTag) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{19} }
+
+func (m *KeyValueTag) GetKey() string {
+	if m != nil && m.Key != nil {
+		return *m.Key
+	}
+	return ""
+}
+
+func (m *KeyValueTag) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// / A proto object representing an entry of the front-matter of a specification.
+type SpecMetadata struct {
+	// / Key of the entry
+	Key *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	// / Values of the entry. An entry holding a single value has one element.
+	Values           []string `protobuf:"bytes,2,rep,name=values" json:"values,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *SpecMetadata) Reset()                    { *m = SpecMetadata{} }
+func (m *SpecMetadata) String() string            { return proto.CompactTextString(m) }
+func (*SpecMetadata) ProtoMessage()               {}
+func (*SpecMetadata) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{20} }
+
+func (m *SpecMetadata) GetKey() string {
+	if m != nil && m.Key != nil {
+		return *m.Key
+	}
+	return ""
+}
+
+func (m *SpecMetadata) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProtoSpec)(nil), "gauge.messages.ProtoSpec")
 	proto.RegisterType((*ProtoItem)(nil), "gauge.messages.ProtoItem")
@@ -1151,6 +1344,9 @@ func init() {
 	proto.RegisterType((*ProtoSuiteResult)(nil), "gauge.messages.ProtoSuiteResult")
 	proto.RegisterType((*ProtoSpecResult)(nil), "gauge.messages.ProtoSpecResult")
 	proto.RegisterType((*ProtoStepValue)(nil), "gauge.messages.ProtoStepValue")
+	proto.RegisterType((*Span)(nil), "gauge.messages.Span")
+	proto.RegisterType((*KeyValueTag)(nil), "gauge.messages.KeyValueTag")
+	proto.RegisterType((*SpecMetadata)(nil), "gauge.messages.SpecMetadata")
 	proto.RegisterEnum("gauge.messages.ProtoItem_ItemType", ProtoItem_ItemType_name, ProtoItem_ItemType_value)
 	proto.RegisterEnum("gauge.messages.Fragment_FragmentType", Fragment_FragmentType_name, Fragment_FragmentType_value)
 	proto.RegisterEnum("gauge.messages.Parameter_ParameterType", Parameter_ParameterType_name, Parameter_ParameterType_value)
@@ -1158,84 +1354,95 @@ func init() {
 }
 
 var fileDescriptor3 = []byte{
-	// 1253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x06, 0x29, 0xca, 0x22, 0xc7, 0x92, 0x4c, 0xd3, 0x4e, 0x42, 0xb7, 0x4e, 0x6a, 0x10, 0x2d,
-	0xac, 0x22, 0xad, 0x1a, 0x18, 0x39, 0xf4, 0x07, 0x2d, 0x10, 0xd8, 0x32, 0x2c, 0x20, 0x4d, 0x03,
-	0x49, 0x48, 0x81, 0x5e, 0x0a, 0x96, 0x5e, 0x2b, 0x8c, 0x25, 0x52, 0xe0, 0xae, 0xec, 0xa4, 0xa7,
-	0x3e, 0x44, 0x9f, 0xa0, 0x97, 0x5e, 0x7a, 0xea, 0x43, 0xf5, 0xda, 0x5b, 0x81, 0xde, 0x3a, 0xb3,
-	0xdc, 0xa5, 0xfe, 0x2d, 0x37, 0x97, 0xdc, 0xb4, 0xc3, 0x99, 0xd9, 0x99, 0x6f, 0x66, 0xbe, 0x59,
-	0x01, 0xf0, 0x11, 0x8b, 0x9a, 0xa3, 0x2c, 0x15, 0xa9, 0x57, 0xef, 0x87, 0xe3, 0x3e, 0x6b, 0x0e,
-	0x19, 0xe7, 0x61, 0x9f, 0xf1, 0xe0, 0x1f, 0x03, 0x9c, 0xe7, 0xf4, 0xa5, 0x8b, 0x3a, 0xde, 0x0e,
-	0x6c, 0x92, 0xee, 0x19, 0x0b, 0xcf, 0xe3, 0xa4, 0xef, 0x1b, 0x07, 0x66, 0xc3, 0xf1, 0x1a, 0x50,
-	0x8e, 0x05, 0x1b, 0x72, 0xdf, 0x3c, 0x28, 0x35, 0x36, 0x8f, 0xf6, 0x9a, 0xb3, 0x2e, 0x9a, 0xd2,
-	0xbc, 0x8d, 0x1a, 0xde, 0x1d, 0xa8, 0xc5, 0xbc, 0x17, 0xfe, 0x34, 0x60, 0x27, 0x59, 0x7c, 0xc5,
-	0x12, 0xbf, 0x84, 0x0e, 0x6c, 0xef, 0x73, 0xa8, 0x8f, 0x32, 0x76, 0x96, 0xa6, 0x97, 0xa7, 0x61,
-	0x3c, 0x18, 0x67, 0xcc, 0xb7, 0x0e, 0x0c, 0xf4, 0x74, 0xb0, 0xd4, 0xd3, 0x94, 0x9e, 0xf7, 0x05,
-	0x6c, 0x8d, 0x52, 0x2e, 0xa6, 0x4d, 0xcb, 0xb7, 0x34, 0x75, 0xc1, 0xbe, 0x88, 0x07, 0xec, 0x59,
-	0x38, 0x64, 0xfe, 0x86, 0xcc, 0xa3, 0x0a, 0x96, 0x08, 0xfb, 0xdc, 0xaf, 0x60, 0x1a, 0x4e, 0xf0,
-	0x8b, 0xa5, 0x12, 0x97, 0x91, 0x3f, 0x06, 0x9b, 0x72, 0xec, 0xbd, 0x19, 0x31, 0x99, 0x75, 0xfd,
-	0x28, 0x58, 0x99, 0x66, 0xb3, 0xad, 0x34, 0xbd, 0x43, 0xb0, 0xb8, 0x60, 0x23, 0x04, 0xc6, 0x58,
-	0x09, 0x4c, 0x17, 0x15, 0xbc, 0x4f, 0xa1, 0x12, 0xa5, 0x49, 0xc4, 0x46, 0x02, 0x21, 0x21, 0xdd,
-	0xfd, 0xa5, 0xba, 0xc7, 0xb9, 0x8e, 0xf7, 0x19, 0xd8, 0x3c, 0x62, 0x49, 0x98, 0xc5, 0xa9, 0x82,
-	0xea, 0xfe, 0x72, 0xdf, 0x4a, 0xc9, 0x6b, 0xc1, 0x8e, 0x98, 0xc0, 0xae, 0xc5, 0x0a, 0xab, 0xc6,
-	0x52, 0xdb, 0xde, 0xa2, 0x7e, 0x1e, 0xe6, 0x70, 0xc8, 0x12, 0x81, 0x90, 0xdd, 0x14, 0xa6, 0xd4,
-	0xf1, 0x3e, 0x86, 0xb2, 0xbc, 0x15, 0x11, 0x25, 0xe5, 0xf7, 0x56, 0xdf, 0x43, 0x48, 0x49, 0xec,
-	0xed, 0x1b, 0x90, 0xea, 0xa1, 0x42, 0xf0, 0x0a, 0xec, 0x02, 0x5e, 0x1b, 0x2c, 0x42, 0xcf, 0x35,
-	0xbc, 0x4d, 0xa8, 0xa8, 0x4b, 0x5d, 0x33, 0x3f, 0x48, 0xa0, 0xdc, 0x12, 0x16, 0xd5, 0xd6, 0xe1,
-	0xbb, 0x96, 0x77, 0x0f, 0x76, 0x96, 0xe4, 0xe5, 0x96, 0x3d, 0x07, 0xca, 0xf2, 0x83, 0xbb, 0x41,
-	0x5e, 0xe9, 0x26, 0xb7, 0x12, 0xfc, 0x5a, 0x82, 0xda, 0x2c, 0x8e, 0xf7, 0x60, 0x4b, 0x03, 0x3f,
-	0x3b, 0x03, 0x75, 0xd8, 0xb8, 0xc0, 0xc6, 0x62, 0xe7, 0x58, 0x6b, 0x6a, 0xe9, 0x87, 0x60, 0x63,
-	0x41, 0x05, 0x7b, 0x2d, 0x38, 0x56, 0x74, 0xcd, 0x58, 0x3c, 0x82, 0x9a, 0xf6, 0xda, 0x96, 0x83,
-	0x64, 0xad, 0xb3, 0x58, 0x9c, 0x98, 0xf2, 0xdb, 0x4f, 0xcc, 0xc6, 0x2d, 0x4d, 0x67, 0xe6, 0x83,
-	0x66, 0x99, 0xbd, 0x66, 0xd1, 0x58, 0xc4, 0x69, 0xd2, 0x8b, 0x71, 0x88, 0xa8, 0x74, 0x25, 0x6f,
-	0x0b, 0x2a, 0xfc, 0x32, 0x1e, 0x8d, 0x10, 0x09, 0x47, 0x22, 0xe1, 0x21, 0xbd, 0xa0, 0xa0, 0x95,
-	0x65, 0x69, 0xc6, 0x7d, 0x90, 0xb6, 0x00, 0x66, 0xfb, 0xc4, 0xdf, 0x44, 0x03, 0x87, 0x92, 0x17,
-	0x2c, 0xcc, 0x4e, 0xd2, 0xeb, 0x84, 0x8a, 0xc9, 0xfd, 0xea, 0x9a, 0xe4, 0x83, 0xa7, 0xe0, 0xaf,
-	0xec, 0xd0, 0x47, 0xe0, 0x68, 0x28, 0x39, 0x96, 0xa6, 0xb4, 0x76, 0x34, 0x82, 0xdf, 0x0b, 0x82,
-	0xa3, 0x41, 0xc4, 0xd0, 0xc2, 0x48, 0x8c, 0xc3, 0x41, 0x0f, 0x4b, 0xa7, 0x6a, 0x8b, 0xb2, 0x51,
-	0x98, 0x71, 0x76, 0x2e, 0x65, 0xa6, 0x94, 0x3d, 0x04, 0xe7, 0x22, 0x0b, 0xfb, 0xd4, 0x71, 0xba,
-	0xc0, 0xfe, 0xfc, 0x3d, 0xa7, 0x4a, 0x81, 0xa6, 0x8f, 0x68, 0xa0, 0xa5, 0xe1, 0xea, 0x30, 0x3e,
-	0x1e, 0x08, 0x35, 0xb9, 0x8d, 0x95, 0xac, 0x30, 0xa7, 0x1f, 0xfc, 0x69, 0x40, 0x75, 0x86, 0x06,
-	0x9a, 0xb0, 0xa9, 0x58, 0x83, 0xd4, 0x65, 0xb4, 0x37, 0xb2, 0x0c, 0x12, 0x35, 0x97, 0x10, 0xaf,
-	0x25, 0xea, 0x33, 0xb8, 0xab, 0x3c, 0xcf, 0x07, 0x5d, 0xfa, 0x9f, 0x41, 0xef, 0x29, 0x74, 0x69,
-	0xa4, 0x8a, 0x0e, 0x32, 0x24, 0xc3, 0xfe, 0x61, 0x80, 0x5d, 0x60, 0xf4, 0x15, 0x54, 0x35, 0xa0,
-	0x53, 0x24, 0xfb, 0xd1, 0x2a, 0x4c, 0x8b, 0x1f, 0x92, 0x08, 0xc8, 0x6f, 0x5e, 0x1b, 0xea, 0xa8,
-	0x4f, 0xc0, 0xc1, 0x7a, 0x21, 0xaf, 0x0b, 0x96, 0xa9, 0x78, 0x17, 0x53, 0xd5, 0x0a, 0xc1, 0x21,
-	0x54, 0x67, 0x7c, 0xd1, 0xf8, 0xa3, 0x2f, 0x24, 0x95, 0x1a, 0x86, 0xae, 0xd5, 0x5c, 0x33, 0xf8,
-	0xdb, 0x98, 0x3a, 0x7b, 0xdf, 0x40, 0xad, 0xb8, 0x64, 0x2a, 0xe0, 0xc3, 0x95, 0x17, 0x4d, 0x7e,
-	0xc9, 0x6b, 0x6a, 0x50, 0xbe, 0x0a, 0x07, 0x63, 0xa6, 0x62, 0xc6, 0x0c, 0x12, 0xda, 0x44, 0x25,
-	0x79, 0x2a, 0x88, 0xd3, 0x5a, 0x47, 0x9c, 0xc1, 0x0f, 0x48, 0x51, 0x33, 0x8e, 0x01, 0x36, 0xba,
-	0x22, 0x14, 0x71, 0x94, 0xd3, 0xe2, 0xc9, 0x1b, 0xf4, 0x8b, 0x07, 0x13, 0xdb, 0xb8, 0x4e, 0x3b,
-	0x3c, 0x0e, 0x07, 0x3f, 0x76, 0x45, 0x86, 0xd4, 0x85, 0xec, 0xb8, 0x0d, 0x35, 0x2d, 0xcb, 0xe9,
-	0xcf, 0x9a, 0x30, 0x61, 0x39, 0xd8, 0x2f, 0xfa, 0x2d, 0xe7, 0x73, 0x0d, 0xb3, 0x1c, 0x8b, 0x20,
-	0x06, 0x98, 0x22, 0xf0, 0x26, 0x54, 0x5e, 0x22, 0x23, 0xb2, 0x8c, 0xab, 0x3e, 0xbc, 0xbf, 0x3a,
-	0xe8, 0x4e, 0x7a, 0x8d, 0x03, 0x64, 0x65, 0xe9, 0xb5, 0x6e, 0xc5, 0x9b, 0x95, 0x83, 0x07, 0x8a,
-	0x87, 0x0b, 0x6b, 0x44, 0x2f, 0x62, 0x83, 0x81, 0xee, 0x24, 0x7c, 0xa4, 0xf8, 0xab, 0x3a, 0xd0,
-	0xfb, 0x1a, 0xb6, 0xd8, 0x5c, 0x13, 0x1b, 0x12, 0xd6, 0x0f, 0x97, 0x5e, 0x3a, 0x6f, 0xbe, 0x48,
-	0xb5, 0xe6, 0xdb, 0x53, 0x6d, 0xe9, 0x96, 0xa6, 0x53, 0x2c, 0x6a, 0x49, 0x16, 0x45, 0xb6, 0x55,
-	0x82, 0x0e, 0x0b, 0x79, 0x9a, 0x48, 0xbe, 0x77, 0x82, 0xdf, 0x4c, 0xd8, 0x5d, 0x1a, 0xf5, 0x64,
-	0x1f, 0x19, 0xd2, 0xde, 0x07, 0x37, 0x63, 0x51, 0x7a, 0xc5, 0x32, 0x82, 0x50, 0x92, 0xb1, 0xcc,
-	0xc3, 0xf6, 0x76, 0xa1, 0xca, 0xe8, 0xf8, 0x6d, 0x1e, 0x8c, 0xea, 0x40, 0x62, 0x6d, 0x11, 0x46,
-	0x97, 0xbd, 0x2c, 0x8c, 0xf2, 0x36, 0xcc, 0x65, 0x51, 0xc6, 0x90, 0x6d, 0x5f, 0xa6, 0x42, 0x06,
-	0x50, 0x5d, 0xdc, 0x02, 0xf4, 0x94, 0x92, 0x5b, 0x40, 0x25, 0xa7, 0xb6, 0xc5, 0x53, 0x70, 0xe4,
-	0x2d, 0x72, 0x54, 0x68, 0x53, 0xd4, 0x8f, 0x9a, 0xb7, 0x81, 0xbf, 0xd9, 0xd2, 0x56, 0x5f, 0x3a,
-	0x4f, 0xba, 0xdd, 0x56, 0xa7, 0xd7, 0xfe, 0xee, 0x59, 0x80, 0x13, 0x5e, 0xc8, 0x69, 0x4c, 0x8b,
-	0x2f, 0xd8, 0xf3, 0x2e, 0x54, 0x5f, 0xb4, 0x3a, 0xed, 0xd3, 0xf6, 0xf1, 0x13, 0x29, 0x31, 0x83,
-	0xe7, 0xe0, 0x2e, 0x00, 0x3c, 0x9b, 0x5f, 0xce, 0xf3, 0xf3, 0x48, 0x98, 0x9a, 0xfd, 0xa7, 0xb2,
-	0x26, 0x74, 0xaa, 0xc1, 0xbf, 0xa6, 0x72, 0xd9, 0x1d, 0xe3, 0xab, 0x50, 0x41, 0xfe, 0x38, 0x7f,
-	0x1b, 0xe7, 0x27, 0xbd, 0x7c, 0x3e, 0x58, 0x4e, 0x94, 0x85, 0xde, 0xbb, 0x69, 0xaf, 0x49, 0x77,
-	0x58, 0xba, 0x3b, 0x28, 0x74, 0x7e, 0x2a, 0x85, 0xc7, 0xe9, 0x38, 0xa1, 0xfa, 0x9a, 0x8d, 0xf2,
-	0xb2, 0xfa, 0xd2, 0x96, 0xa7, 0xff, 0x01, 0xe3, 0x28, 0xc2, 0x0b, 0x3a, 0xa1, 0xa0, 0x1a, 0x9b,
-	0x0d, 0x93, 0x84, 0x2c, 0xb9, 0x8a, 0xb3, 0x34, 0x91, 0x2f, 0x44, 0x5b, 0x13, 0x9b, 0xa4, 0x7c,
-	0x47, 0x9e, 0x50, 0x05, 0xff, 0x66, 0xbc, 0x62, 0x91, 0x90, 0xef, 0x6e, 0x90, 0x08, 0x6f, 0x83,
-	0x23, 0xd0, 0x35, 0xd6, 0x63, 0x38, 0xc2, 0x47, 0x01, 0x89, 0xf6, 0x60, 0x5b, 0x06, 0xd4, 0xcd,
-	0x7b, 0x3e, 0x8f, 0xa8, 0x4a, 0x11, 0x05, 0x7f, 0x19, 0xb0, 0x35, 0x0f, 0x22, 0x31, 0xbe, 0x16,
-	0xdd, 0xbc, 0x06, 0xe9, 0x4f, 0xcc, 0x9d, 0xc9, 0x73, 0x2b, 0x77, 0x6c, 0xca, 0x54, 0xdf, 0xc7,
-	0x2d, 0xad, 0xc4, 0xd3, 0x38, 0x94, 0xe4, 0xc7, 0x79, 0xc4, 0x50, 0x39, 0x3f, 0x9f, 0x84, 0x22,
-	0xd4, 0xb4, 0xc4, 0x11, 0xb4, 0xd2, 0x6a, 0xd0, 0xa6, 0x86, 0xba, 0x22, 0x9d, 0xec, 0xc3, 0xae,
-	0xbe, 0x71, 0x26, 0x51, 0x47, 0x26, 0xfa, 0x3d, 0xd4, 0x0b, 0x4e, 0x7b, 0x41, 0xab, 0x82, 0x80,
-	0xe2, 0xfa, 0xa0, 0x7a, 0xf6, 0x01, 0xdc, 0x2d, 0xd6, 0x50, 0xfc, 0x33, 0x3b, 0x2f, 0x94, 0x27,
-	0xdd, 0x5b, 0x7c, 0xcf, 0x1f, 0x2a, 0xce, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xee, 0xbb,
-	0x95, 0xef, 0x0d, 0x00, 0x00,
+	// 1430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4b, 0x6f, 0xdb, 0xd6,
+	0x12, 0x06, 0x1f, 0xb2, 0xc8, 0x11, 0x25, 0xd3, 0xc7, 0x76, 0xc2, 0xdc, 0x24, 0xf7, 0x0a, 0xc4,
+	0xbd, 0xd7, 0x0a, 0x9c, 0xa8, 0x89, 0x91, 0x45, 0x1f, 0x68, 0x81, 0xc0, 0x8f, 0xc6, 0x68, 0x92,
+	0x06, 0x96, 0x90, 0x02, 0xdd, 0x14, 0xa7, 0xd4, 0x44, 0x61, 0x2c, 0x91, 0x04, 0xcf, 0x91, 0x63,
+	0x77, 0xd5, 0xff, 0xd0, 0x7f, 0xd0, 0x75, 0x57, 0xfd, 0x1b, 0xfd, 0x1f, 0xdd, 0x76, 0x57, 0xa0,
+	0x8b, 0x02, 0xc5, 0x19, 0x3e, 0x44, 0xbd, 0x6c, 0x37, 0x9b, 0x2e, 0x39, 0xfc, 0xce, 0x9c, 0x99,
+	0x6f, 0x1e, 0x1f, 0x09, 0x20, 0x12, 0x0c, 0xba, 0x49, 0x1a, 0xcb, 0x98, 0xb5, 0x86, 0x7c, 0x32,
+	0xc4, 0xee, 0x18, 0x85, 0xe0, 0x43, 0x14, 0xfe, 0x9f, 0x3a, 0xd8, 0x2f, 0xd5, 0x9b, 0x5e, 0x82,
+	0x01, 0xdb, 0x84, 0x86, 0xc2, 0x3e, 0x45, 0x3e, 0x08, 0xa3, 0xa1, 0xa7, 0xb5, 0xf5, 0x8e, 0xcd,
+	0x3a, 0x50, 0x0b, 0x25, 0x8e, 0x85, 0xa7, 0xb7, 0x8d, 0x4e, 0x63, 0xef, 0x56, 0x77, 0xd6, 0x45,
+	0x97, 0x8e, 0x1f, 0x4b, 0x1c, 0xb3, 0x6d, 0x68, 0x86, 0xa2, 0xcf, 0xbf, 0x1d, 0xe1, 0x41, 0x1a,
+	0x9e, 0x61, 0xe4, 0x19, 0x6d, 0xbd, 0x63, 0xb1, 0x0f, 0xa1, 0x95, 0xa4, 0xf8, 0x34, 0x8e, 0x4f,
+	0x8f, 0x78, 0x38, 0x9a, 0xa4, 0xe8, 0x99, 0x6d, 0xad, 0xd3, 0xd8, 0x6b, 0x2f, 0xf5, 0x54, 0xc1,
+	0xb1, 0x8f, 0x60, 0x3d, 0x89, 0x85, 0xac, 0x1e, 0xad, 0x5d, 0xf3, 0xa8, 0x0b, 0xd6, 0xeb, 0x70,
+	0x84, 0x2f, 0xf8, 0x18, 0xbd, 0x35, 0xca, 0xc3, 0x01, 0x53, 0xf2, 0xa1, 0xf0, 0xea, 0x6d, 0xa3,
+	0x63, 0xb3, 0x07, 0xb0, 0x5e, 0x49, 0xb5, 0x97, 0xf0, 0xc8, 0xb3, 0xc8, 0xf5, 0xd6, 0xbc, 0x6b,
+	0xf5, 0x8e, 0xfd, 0x1f, 0x2c, 0xc9, 0x09, 0x26, 0x3c, 0xbb, 0x6d, 0xac, 0xc4, 0x75, 0xc1, 0x1a,
+	0xa3, 0xe4, 0x03, 0x2e, 0xb9, 0x07, 0x84, 0xbb, 0xb3, 0x88, 0xc3, 0xe0, 0x79, 0x8e, 0xf1, 0xbf,
+	0x37, 0xc1, 0x9e, 0x12, 0xf8, 0x18, 0x2c, 0x45, 0x75, 0xff, 0x22, 0x41, 0x22, 0xbf, 0xb5, 0xe7,
+	0xaf, 0x64, 0xbb, 0x7b, 0x9c, 0x23, 0xd9, 0x0e, 0x98, 0x42, 0x62, 0xe2, 0xe9, 0x6d, 0x6d, 0x65,
+	0x7d, 0x7a, 0x12, 0x13, 0xf6, 0x00, 0xea, 0x41, 0x1c, 0x05, 0x98, 0x48, 0xcf, 0x68, 0x6b, 0xcb,
+	0x62, 0x23, 0xec, 0x7e, 0x86, 0x61, 0x1f, 0x80, 0x25, 0x02, 0x8c, 0x78, 0x1a, 0xc6, 0x79, 0xc5,
+	0xee, 0x2e, 0xf7, 0x9d, 0x83, 0xd8, 0x21, 0x6c, 0xca, 0x69, 0xf5, 0x0b, 0x73, 0x5e, 0xb2, 0xce,
+	0xd2, 0xb3, 0xfd, 0x45, 0x7c, 0x16, 0xe6, 0x78, 0x8c, 0x91, 0xf4, 0xd6, 0x2e, 0x0d, 0x93, 0x30,
+	0xec, 0x1e, 0xd4, 0xe8, 0x56, 0xaf, 0x4e, 0xe0, 0x7f, 0xad, 0xbe, 0x87, 0xed, 0xe4, 0x2d, 0x60,
+	0x5d, 0xc2, 0x54, 0x9f, 0x0f, 0x85, 0xff, 0x16, 0xac, 0x92, 0x5e, 0x0b, 0x4c, 0xc5, 0x9e, 0xab,
+	0xb1, 0x06, 0xd4, 0xf3, 0x4b, 0x5d, 0x3d, 0x7b, 0x20, 0xa2, 0x5c, 0x83, 0x39, 0x60, 0x15, 0xe1,
+	0xbb, 0x26, 0xbb, 0x09, 0x9b, 0x4b, 0xf2, 0x72, 0x6b, 0xcc, 0x86, 0x1a, 0xbd, 0x70, 0xd7, 0x94,
+	0x57, 0x75, 0x93, 0x5b, 0xf7, 0x7f, 0x33, 0xa0, 0x39, 0xcb, 0xe3, 0x4d, 0x58, 0x2f, 0x88, 0x9f,
+	0x1d, 0xc5, 0x16, 0xac, 0xbd, 0xe6, 0xe1, 0x08, 0x07, 0x9e, 0x4e, 0x93, 0xb5, 0x0b, 0x56, 0x10,
+	0x47, 0x12, 0xcf, 0xa5, 0xf0, 0x8c, 0xab, 0xa6, 0xf3, 0x21, 0x34, 0x0b, 0xaf, 0xc7, 0x34, 0xcf,
+	0xe6, 0x55, 0x27, 0x16, 0x07, 0xb7, 0xf6, 0xfe, 0x83, 0xbb, 0x76, 0xcd, 0xa3, 0xb3, 0x63, 0xba,
+	0x0d, 0x4d, 0x3c, 0xc7, 0x60, 0x22, 0xc3, 0x38, 0xea, 0x87, 0x63, 0xa4, 0xd2, 0x19, 0x6c, 0x1d,
+	0xea, 0xe2, 0x34, 0x4c, 0x12, 0x1c, 0x78, 0x36, 0x31, 0xc1, 0x00, 0x94, 0xe1, 0x30, 0x4d, 0xe3,
+	0x54, 0xd0, 0xe4, 0xd9, 0x0c, 0x40, 0x3f, 0x3e, 0xf0, 0x1a, 0x6d, 0xad, 0x63, 0xab, 0xe4, 0x25,
+	0xf2, 0xf4, 0x20, 0x7e, 0x17, 0xa9, 0x62, 0x0a, 0xcf, 0xb9, 0x2a, 0xf9, 0x47, 0xb0, 0x39, 0x57,
+	0x04, 0x5a, 0x12, 0xcd, 0x6b, 0x2e, 0x89, 0xd6, 0xea, 0x25, 0xe1, 0x3f, 0x03, 0x6f, 0x65, 0xf3,
+	0x3f, 0x04, 0xbb, 0xb8, 0x56, 0x78, 0x5a, 0xdb, 0xb8, 0x72, 0xea, 0xfc, 0x5f, 0x34, 0xb0, 0xa7,
+	0x33, 0xce, 0x00, 0x78, 0x20, 0x27, 0x7c, 0xd4, 0xc7, 0x73, 0x99, 0xb7, 0x0d, 0x03, 0x48, 0x78,
+	0x2a, 0x70, 0x40, 0x36, 0x9d, 0x6c, 0xbb, 0x60, 0xbf, 0x4e, 0xf9, 0x50, 0x35, 0x73, 0xd1, 0x3b,
+	0xde, 0xfc, 0x3d, 0x47, 0x39, 0x40, 0x0d, 0xb6, 0xda, 0x30, 0x87, 0x45, 0x25, 0x4e, 0x50, 0x4c,
+	0x46, 0xd2, 0x33, 0x2f, 0x19, 0xec, 0xde, 0x22, 0x9e, 0xf9, 0x60, 0x0a, 0xc5, 0x61, 0x6d, 0x35,
+	0x87, 0xfe, 0xcf, 0x1a, 0x38, 0x33, 0x5b, 0xa8, 0x0b, 0x8d, 0x7c, 0x69, 0x29, 0x97, 0x94, 0xd1,
+	0xa5, 0x4b, 0xae, 0x03, 0x35, 0x41, 0x15, 0xbe, 0x52, 0xae, 0x9e, 0xc2, 0x8d, 0xdc, 0xf3, 0x7c,
+	0x62, 0xc6, 0xdf, 0x4b, 0xcc, 0x97, 0x79, 0x05, 0xd4, 0x44, 0x97, 0x0d, 0xac, 0x51, 0x13, 0x56,
+	0x7b, 0x42, 0xbf, 0x44, 0x38, 0x1e, 0x81, 0x73, 0x8a, 0x17, 0xaf, 0xf8, 0x68, 0x82, 0xca, 0x4b,
+	0x5e, 0x92, 0xdb, 0xf3, 0xd8, 0x2f, 0xa6, 0x18, 0xff, 0x27, 0x0d, 0xac, 0xb2, 0x44, 0x9f, 0x80,
+	0x53, 0xd4, 0xb3, 0x22, 0x1f, 0xff, 0x5b, 0x55, 0xd2, 0xee, 0x51, 0x05, 0x4c, 0x21, 0x67, 0xad,
+	0xa1, 0x66, 0xe5, 0x3e, 0xd8, 0x09, 0x4f, 0xf9, 0x18, 0x25, 0xa6, 0x39, 0x15, 0x8b, 0x2c, 0x16,
+	0x00, 0x7f, 0x07, 0x9c, 0x19, 0x5f, 0x6a, 0xb1, 0xe1, 0xb9, 0x74, 0x35, 0xd6, 0x04, 0xbb, 0x84,
+	0xb9, 0xba, 0xff, 0x83, 0x5e, 0x79, 0x66, 0x9f, 0x41, 0xb3, 0xbc, 0xa4, 0x12, 0xf0, 0xce, 0xca,
+	0x8b, 0xba, 0x2f, 0xab, 0x70, 0xd6, 0x84, 0xda, 0x99, 0x22, 0x22, 0x8f, 0xd9, 0x01, 0x33, 0x52,
+	0x52, 0x6f, 0xd0, 0x53, 0x29, 0x09, 0xe6, 0x95, 0x92, 0x70, 0x9d, 0x9e, 0xfc, 0x1a, 0x9a, 0xb3,
+	0x97, 0x03, 0xac, 0xf5, 0x24, 0x97, 0x61, 0x90, 0x89, 0xc2, 0xc1, 0x45, 0xc4, 0xc7, 0x61, 0xe0,
+	0xea, 0x8c, 0x41, 0x4b, 0xc9, 0x7b, 0xc8, 0x47, 0xdf, 0xf4, 0x64, 0x1a, 0x46, 0x43, 0xd7, 0x60,
+	0x1b, 0xd0, 0x2c, 0x6c, 0xd9, 0xf2, 0x37, 0xa7, 0x3a, 0x50, 0xf3, 0xef, 0x94, 0xed, 0x9e, 0xa9,
+	0x59, 0x51, 0x0a, 0x9a, 0x5c, 0x3f, 0x04, 0xa8, 0xc4, 0xda, 0x85, 0xfa, 0x1b, 0xe4, 0x03, 0x4c,
+	0x45, 0x3e, 0x06, 0x77, 0x57, 0x27, 0x76, 0x12, 0xbf, 0x63, 0xbb, 0x60, 0xa6, 0xf1, 0xbb, 0xa2,
+	0xef, 0x2e, 0x07, 0xfb, 0x9f, 0x43, 0x73, 0xc6, 0xa0, 0x18, 0x0e, 0x70, 0x34, 0x2a, 0x1a, 0x79,
+	0x07, 0x6c, 0xf5, 0x78, 0x65, 0x27, 0xfb, 0xbf, 0x6b, 0xe0, 0xad, 0x9a, 0x14, 0xf6, 0x29, 0xac,
+	0xe3, 0xac, 0xc9, 0xd3, 0x88, 0xf9, 0xff, 0x2e, 0x8d, 0x6e, 0xfe, 0xf8, 0xa2, 0x22, 0xe9, 0xef,
+	0xaf, 0x48, 0xc6, 0x35, 0x8f, 0x56, 0xc4, 0xc6, 0x24, 0xb1, 0xd9, 0x86, 0x66, 0x6e, 0x38, 0x41,
+	0x2e, 0xe2, 0xac, 0x79, 0x6c, 0xff, 0x47, 0x1d, 0xb6, 0x96, 0x46, 0x3d, 0x95, 0x6d, 0x8d, 0xce,
+	0x7b, 0xe0, 0xa6, 0x18, 0xc4, 0x67, 0x98, 0x2a, 0xae, 0x49, 0xb3, 0x28, 0x0f, 0x8b, 0x6d, 0x81,
+	0x83, 0xea, 0xf1, 0x79, 0x16, 0x4c, 0xde, 0xce, 0x4a, 0xdc, 0x24, 0x0f, 0x4e, 0xfb, 0x29, 0x0f,
+	0xb2, 0x9e, 0xce, 0x6c, 0x41, 0x8a, 0x18, 0xf5, 0xde, 0xc4, 0x92, 0x02, 0x70, 0x16, 0xc5, 0x52,
+	0x7d, 0xf8, 0x92, 0x58, 0xe6, 0xc9, 0xe5, 0xa2, 0xfa, 0x0c, 0x6c, 0xba, 0x85, 0xe6, 0x4e, 0x09,
+	0x6a, 0x6b, 0xaf, 0x7b, 0x1d, 0xfa, 0xbb, 0x87, 0xc5, 0xa9, 0x8f, 0xed, 0x27, 0xbd, 0xde, 0xe1,
+	0x49, 0xff, 0xf8, 0xcb, 0x17, 0xfe, 0x7d, 0xb0, 0x4b, 0xbb, 0x9a, 0xf9, 0xf2, 0x8d, 0xab, 0x31,
+	0x17, 0x9c, 0x57, 0x87, 0x27, 0xc7, 0x47, 0xc7, 0xfb, 0x4f, 0xc8, 0xa2, 0xfb, 0x2f, 0xc1, 0x5d,
+	0x20, 0x78, 0x36, 0xbf, 0x4c, 0xb3, 0xe6, 0x99, 0xd0, 0xdb, 0xfa, 0x42, 0xd6, 0x8a, 0x1d, 0xc7,
+	0xff, 0x43, 0xcf, 0x5d, 0xf6, 0x26, 0xa1, 0xc4, 0x9c, 0xf2, 0xc7, 0xd9, 0x9f, 0x4c, 0xf6, 0x54,
+	0x08, 0xe9, 0x7f, 0x96, 0x2f, 0xf4, 0x12, 0xf7, 0xcf, 0xb4, 0xd7, 0xb4, 0x3b, 0xcc, 0xa2, 0x3b,
+	0x54, 0xe8, 0xe2, 0x88, 0x8c, 0xfb, 0xf1, 0x24, 0x52, 0xf5, 0xd5, 0x3b, 0xb5, 0x65, 0xf5, 0x55,
+	0x1f, 0x43, 0xea, 0xaf, 0x6d, 0x12, 0x04, 0x28, 0xc4, 0x09, 0x97, 0xaa, 0xc6, 0x7a, 0x47, 0x57,
+	0x46, 0x8c, 0xce, 0xc2, 0x34, 0x8e, 0xe8, 0x43, 0xda, 0x2a, 0xb6, 0x24, 0x49, 0x93, 0x4d, 0x4f,
+	0x9b, 0xd0, 0x48, 0xd2, 0xf8, 0x2d, 0x06, 0x92, 0xfe, 0x92, 0x80, 0x18, 0xde, 0x00, 0x5b, 0x86,
+	0x63, 0x14, 0x92, 0x8f, 0x13, 0xaf, 0x41, 0xa6, 0x5b, 0xb0, 0x41, 0x01, 0xf5, 0xb2, 0x9e, 0xcf,
+	0x22, 0x72, 0x54, 0x44, 0xfe, 0xaf, 0x1a, 0xac, 0xcf, 0x93, 0xa8, 0xe4, 0xa3, 0x30, 0x5d, 0x2e,
+	0xd7, 0xea, 0x97, 0x73, 0x7b, 0xfa, 0x55, 0x9a, 0x39, 0xd6, 0x29, 0xd5, 0xdb, 0xd3, 0xaf, 0xaf,
+	0x2a, 0x0f, 0x06, 0xbd, 0x9c, 0x67, 0xec, 0x36, 0x6c, 0x66, 0xcf, 0x07, 0x5c, 0xf2, 0x62, 0x7f,
+	0x09, 0xaf, 0xd6, 0x36, 0x56, 0x93, 0x56, 0x19, 0xea, 0x3a, 0x39, 0xb9, 0x03, 0x5b, 0xc5, 0x8d,
+	0x33, 0x89, 0xda, 0x94, 0xe8, 0x57, 0xd0, 0x2a, 0x77, 0x1a, 0x09, 0xb0, 0x22, 0x4a, 0x14, 0x0f,
+	0x79, 0xcf, 0xfe, 0x1b, 0x6e, 0x94, 0x9a, 0x16, 0x7e, 0x87, 0x83, 0x12, 0x3c, 0xed, 0xde, 0xf2,
+	0x7d, 0xa6, 0xf0, 0xb6, 0x7f, 0x02, 0x26, 0xe9, 0x3f, 0xb9, 0xe3, 0xa9, 0x7c, 0x16, 0x46, 0x99,
+	0xbb, 0x1a, 0xd5, 0x55, 0x99, 0xf6, 0xe3, 0xd1, 0x64, 0x1c, 0xe5, 0xc4, 0xac, 0x43, 0x1d, 0xa3,
+	0x01, 0xa1, 0x32, 0x32, 0x36, 0xc0, 0xc6, 0x68, 0x90, 0x63, 0x4c, 0x0a, 0xf6, 0x1e, 0x34, 0x2a,
+	0xdf, 0x09, 0xac, 0x01, 0xc6, 0x29, 0x5e, 0xd0, 0x9e, 0xb5, 0xe7, 0x74, 0xd3, 0xdf, 0x05, 0xa7,
+	0xfa, 0x3f, 0x3a, 0x8b, 0x6d, 0xc1, 0x1a, 0x61, 0xb3, 0x7d, 0x6f, 0xff, 0x35, 0x00, 0x98, 0xb1,
+	0x03, 0x60, 0x49, 0x10, 0x00, 0x00,
 }
@@ -31,6 +31,8 @@ type ConceptDictionary struct {
 	ConceptsMap     map[string]*Concept
 	constructionMap map[string][]*Step
 	referenceMap    map[*Step][]*Step
+	// concepts keyed by the step values which omit their parameters with default values
+	partialConceptsMap map[string]*Concept
 }
 
 type Concept struct {
@@ -39,8 +41,9 @@ type Concept struct {
 }
 
 type ConceptParser struct {
-	currentState   int
-	currentConcept *Step
+	currentState    int
+	currentConcept  *Step
+	currentDefaults *DataTable
 }
 
 //concept file can have multiple concept headings
@@ -66,6 +69,7 @@ func (parser *ConceptParser) ParseFile(file string) ([]*Step, *ParseDetailResult
 func (parser *ConceptParser) resetState() {
 	parser.currentState = initial
 	parser.currentConcept = nil
+	parser.currentDefaults = nil
 }
 
 func (parser *ConceptParser) createConcepts(tokens []*Token) ([]*Step, *ParseDetailResult) {
@@ -83,6 +87,7 @@ func (parser *ConceptParser) createConcepts(tokens []*Token) ([]*Step, *ParseDet
 			if parseDetails.Error != nil {
 				return nil, parseDetails
 			}
+			parser.currentDefaults = nil
 			if addPreComments {
 				parser.currentConcept.PreComments = preComments
 				addPreComments = false
@@ -92,10 +97,21 @@ func (parser *ConceptParser) createConcepts(tokens []*Token) ([]*Step, *ParseDet
 			if !isInState(parser.currentState, conceptScope) {
 				return nil, &ParseDetailResult{Error: &ParseError{LineNo: token.LineNo, Message: "Step is not defined inside a concept heading", LineText: token.LineText}}
 			}
+			if err := parser.validateDefaults(); err != nil {
+				return nil, &ParseDetailResult{Error: err}
+			}
 			if err := parser.processConceptStep(token); err != nil {
 				return nil, &ParseDetailResult{Error: err}
 			}
 			addStates(&parser.currentState, stepScope)
+		} else if parser.isTableHeader(token) && parser.isDefaultsTable() {
+			if err := parser.processDefaultsHeader(token); err != nil {
+				return nil, &ParseDetailResult{Error: err}
+			}
+		} else if parser.isTableDataRow(token) && parser.isDefaultsTable() {
+			if err := parser.processDefaultsRow(token); err != nil {
+				return nil, &ParseDetailResult{Error: err}
+			}
 		} else if parser.isTableHeader(token) {
 			if !isInState(parser.currentState, stepScope) {
 				return nil, &ParseDetailResult{Error: &ParseError{LineNo: token.LineNo, Message: "Table doesn't belong to any step", LineText: token.LineText}}
@@ -104,6 +120,11 @@ func (parser *ConceptParser) createConcepts(tokens []*Token) ([]*Step, *ParseDet
 			addStates(&parser.currentState, tableScope)
 		} else if parser.isTableDataRow(token) {
 			parser.processTableDataRow(token, &parser.currentConcept.Lookup)
+		} else if token.Kind == TextBlockKind {
+			if !isInState(parser.currentState, stepScope) {
+				return nil, &ParseDetailResult{Error: &ParseError{LineNo: token.LineNo, Message: "Text block doesn't belong to any step", LineText: token.LineText}}
+			}
+			parser.processTextBlock(token)
 		} else {
 			comment := &Comment{Value: token.Value, LineNo: token.LineNo}
 			if parser.currentConcept == nil {
@@ -142,7 +163,8 @@ func (parser *ConceptParser) isTableDataRow(token *Token) bool {
 
 func (parser *ConceptParser) processConceptHeading(token *Token) (*Step, *ParseDetailResult) {
 	processStep(new(SpecParser), token)
-	token.LineText = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(token.LineText), "#"))
+	headingLine := strings.Split(token.LineText, "\n")[0]
+	token.LineText = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(headingLine), "#"))
 	var concept *Step
 	var parseDetails *ParseDetailResult
 	concept, parseDetails = new(Specification).CreateStepUsingLookup(token, nil)
@@ -184,6 +206,10 @@ func (parser *ConceptParser) processTableHeader(token *Token) {
 }
 
 func (parser *ConceptParser) processTableDataRow(token *Token, argLookup *ArgLookup) {
+	if areUnderlined(token.Args) {
+		// skip table separator
+		return
+	}
 	steps := parser.currentConcept.ConceptSteps
 	currentStep := steps[len(steps)-1]
 	addInlineTableRow(currentStep, token, argLookup)
@@ -191,6 +217,64 @@ func (parser *ConceptParser) processTableDataRow(token *Token, argLookup *ArgLoo
 	items[len(items)-1] = currentStep
 }
 
+func (parser *ConceptParser) processTextBlock(token *Token) {
+	steps := parser.currentConcept.ConceptSteps
+	addTextBlock(steps[len(steps)-1], token)
+}
+
+// isDefaultsTable tells whether a table belongs to the concept heading, where it gives default values of parameters.
+func (parser *ConceptParser) isDefaultsTable() bool {
+	return parser.currentConcept != nil && len(parser.currentConcept.Args) > 0 && len(parser.currentConcept.ConceptSteps) == 0
+}
+
+// processDefaultsHeader takes the parameters with default values from the headers of the table.
+// Only the trailing parameters of a concept heading can have default values.
+func (parser *ConceptParser) processDefaultsHeader(token *Token) *ParseError {
+	concept := parser.currentConcept
+	if parser.currentDefaults != nil {
+		return &ParseError{LineNo: token.LineNo, Message: "Concept can have only one table of default values", LineText: token.LineText}
+	}
+	defaulted := make(map[string]bool, 0)
+	for _, param := range token.Args {
+		if !concept.Lookup.containsArg(param) {
+			return &ParseError{LineNo: token.LineNo, Message: fmt.Sprintf("Default value given for unknown parameter <%s>", param), LineText: token.LineText}
+		}
+		defaulted[param] = true
+	}
+	for _, arg := range concept.Args[len(concept.Args)-len(token.Args):] {
+		if !defaulted[arg.Value] {
+			return &ParseError{LineNo: token.LineNo, Message: "Only the trailing parameters of a concept can have default values", LineText: token.LineText}
+		}
+	}
+	parser.currentDefaults = &DataTable{}
+	parser.currentDefaults.Table.AddHeaders(token.Args)
+	concept.DefaultArgCount = len(token.Args)
+	concept.Items = append(concept.Items, parser.currentDefaults)
+	return nil
+}
+
+func (parser *ConceptParser) processDefaultsRow(token *Token) *ParseError {
+	if areUnderlined(token.Args) {
+		return nil
+	}
+	if parser.currentDefaults.Table.GetRowCount() > 0 {
+		return &ParseError{LineNo: token.LineNo, Message: "Table of default values can have only one row", LineText: token.LineText}
+	}
+	parser.currentDefaults.Table.AddRowValues(token.Args)
+	for _, param := range parser.currentDefaults.Table.Headers {
+		value := parser.currentDefaults.Table.Get(param)[0].Value
+		parser.currentConcept.Lookup.addArgValue(param, &StepArg{Name: param, Value: value, ArgType: Static})
+	}
+	return nil
+}
+
+func (parser *ConceptParser) validateDefaults() *ParseError {
+	if parser.currentDefaults != nil && parser.currentDefaults.Table.GetRowCount() == 0 {
+		return &ParseError{LineNo: parser.currentConcept.LineNo, Message: "Table of default values should have a row of values", LineText: parser.currentConcept.LineText}
+	}
+	return nil
+}
+
 func (parser *ConceptParser) hasOnlyDynamicParams(step *Step) bool {
 	for _, arg := range step.Args {
 		if arg.ArgType != Dynamic {
@@ -239,9 +323,7 @@ func NewConceptDictionary() *ConceptDictionary {
 }
 
 func (conceptDictionary *ConceptDictionary) isConcept(step *Step) bool {
-	_, ok := conceptDictionary.ConceptsMap[step.Value]
-	return ok
-
+	return conceptDictionary.search(step.Value) != nil
 }
 func (conceptDictionary *ConceptDictionary) Add(concepts []*Step, conceptFile string) *ParseError {
 	if conceptDictionary.ConceptsMap == nil {
@@ -250,10 +332,16 @@ func (conceptDictionary *ConceptDictionary) Add(concepts []*Step, conceptFile st
 	if conceptDictionary.constructionMap == nil {
 		conceptDictionary.constructionMap = make(map[string][]*Step)
 	}
+	if conceptDictionary.partialConceptsMap == nil {
+		conceptDictionary.partialConceptsMap = make(map[string]*Concept)
+	}
 	for _, conceptStep := range concepts {
 		if _, exists := conceptDictionary.ConceptsMap[conceptStep.Value]; exists {
 			return &ParseError{Message: "Duplicate concept definition found", LineNo: conceptStep.LineNo, LineText: conceptStep.LineText}
 		}
+		if err := conceptDictionary.addPartialValues(&Concept{conceptStep, conceptFile}); err != nil {
+			return err
+		}
 		conceptDictionary.replaceNestedConceptSteps(conceptStep)
 		conceptDictionary.ConceptsMap[conceptStep.Value] = &Concept{conceptStep, conceptFile}
 	}
@@ -261,13 +349,62 @@ func (conceptDictionary *ConceptDictionary) Add(concepts []*Step, conceptFile st
 	return conceptDictionary.validateConcepts()
 }
 
+// Search finds the concept called by a step with the given value, including steps which omit the parameters
+// with default values.
+func (conceptDictionary *ConceptDictionary) Search(stepValue string) *Concept {
+	return conceptDictionary.search(stepValue)
+}
+
 func (conceptDictionary *ConceptDictionary) search(stepValue string) *Concept {
 	if concept, ok := conceptDictionary.ConceptsMap[stepValue]; ok {
 		return concept
 	}
+	if concept, ok := conceptDictionary.partialConceptsMap[stepValue]; ok {
+		return concept
+	}
 	return nil
 }
 
+// addPartialValues makes the concept match the steps which omit its parameters with default values.
+// Concepts matching the same step are ambiguous.
+func (conceptDictionary *ConceptDictionary) addPartialValues(concept *Concept) *ParseError {
+	conceptStep := concept.ConceptStep
+	if existing, ok := conceptDictionary.partialConceptsMap[conceptStep.Value]; ok {
+		return ambiguousConceptError(conceptStep, existing.ConceptStep)
+	}
+	partialValues := partialStepValues(conceptStep)
+	for _, value := range partialValues {
+		if existing := conceptDictionary.search(value); existing != nil {
+			return ambiguousConceptError(conceptStep, existing.ConceptStep)
+		}
+	}
+	for _, value := range partialValues {
+		conceptDictionary.partialConceptsMap[value] = concept
+		conceptDictionary.updateStepsWithValue(value, conceptStep)
+	}
+	return nil
+}
+
+// partialStepValues are the step values of a concept heading when its trailing parameters with default values
+// are omitted, along with the text following the first omitted parameter.
+func partialStepValues(concept *Step) []string {
+	values := make([]string, 0)
+	parts := strings.Split(concept.Value, ParameterPlaceholder)
+	for argCount := len(concept.Args) - concept.DefaultArgCount; argCount < len(concept.Args); argCount++ {
+		value := parts[0]
+		if argCount > 0 {
+			value = strings.Join(parts[:argCount], ParameterPlaceholder) + ParameterPlaceholder
+		}
+		values = append(values, strings.TrimSpace(value))
+	}
+	return values
+}
+
+func ambiguousConceptError(concept *Step, existing *Step) *ParseError {
+	return &ParseError{LineNo: concept.LineNo, LineText: concept.LineText,
+		Message: fmt.Sprintf("Ambiguous concept definition found, \"%s\" and \"%s\" match the same steps", concept.LineText, existing.LineText)}
+}
+
 func (conceptDictionary *ConceptDictionary) validateConcepts() *ParseError {
 	for _, concept := range conceptDictionary.ConceptsMap {
 		err := conceptDictionary.checkCircularReferencing(concept.ConceptStep, nil)
@@ -318,10 +455,14 @@ func (conceptDictionary *ConceptDictionary) replaceNestedConceptSteps(conceptSte
 
 //mutates the step with concept steps so that anyone who is referencing the step will now refer a concept
 func (conceptDictionary *ConceptDictionary) updateStep(step *Step) {
-	conceptDictionary.constructionMap[step.Value] = append(conceptDictionary.constructionMap[step.Value], step)
-	if !conceptDictionary.constructionMap[step.Value][0].IsConcept {
-		conceptDictionary.constructionMap[step.Value] = append(conceptDictionary.constructionMap[step.Value], step)
-		for _, allSteps := range conceptDictionary.constructionMap[step.Value] {
+	conceptDictionary.updateStepsWithValue(step.Value, step)
+}
+
+func (conceptDictionary *ConceptDictionary) updateStepsWithValue(value string, step *Step) {
+	conceptDictionary.constructionMap[value] = append(conceptDictionary.constructionMap[value], step)
+	if !conceptDictionary.constructionMap[value][0].IsConcept {
+		conceptDictionary.constructionMap[value] = append(conceptDictionary.constructionMap[value], step)
+		for _, allSteps := range conceptDictionary.constructionMap[value] {
 			allSteps.IsConcept = step.IsConcept
 			allSteps.ConceptSteps = step.ConceptSteps
 			allSteps.Lookup = *step.Lookup.getCopy()
@@ -334,7 +475,7 @@ func (conceptDictionary *ConceptDictionary) updateLookupForNestedConcepts() {
 		for _, stepInsideConcept := range concept.ConceptStep.ConceptSteps {
 			stepInsideConcept.Parent = concept.ConceptStep
 			if nestedConcept := conceptDictionary.search(stepInsideConcept.Value); nestedConcept != nil {
-				for i, arg := range nestedConcept.ConceptStep.Args {
+				for i, arg := range nestedConcept.ConceptStep.Args[:len(stepInsideConcept.Args)] {
 					stepInsideConcept.Lookup.addArgValue(arg.Value, &StepArg{ArgType: stepInsideConcept.Args[i].ArgType, Value: stepInsideConcept.Args[i].Value})
 				}
 			}
@@ -473,6 +473,15 @@ func (s *MySuite) TestParsingConceptStepWithInlineTable(c *C) {
 	c.Assert(inlineTable.Get("name")[1].CellType, Equals, Static)
 }
 
+func (s *MySuite) TestParsingConceptStepWithInlineTableSkipsSeparator(c *C) {
+	concepts, parseRes := new(ConceptParser).Parse("# my concept\n* step with table\n |id|name|\n |--|----|\n |1 |vishnu|\n")
+
+	c.Assert(parseRes.Error, IsNil)
+	inlineTable := concepts[0].ConceptSteps[0].Args[0].Table
+	c.Assert(inlineTable.GetRowCount(), Equals, 1)
+	c.Assert(inlineTable.Get("name")[0].Value, Equals, "vishnu")
+}
+
 func (s *MySuite) TestErrorParsingConceptWithInvalidInlineTable(c *C) {
 	parser := new(ConceptParser)
 	_, parseRes := parser.Parse("# my concept \n |id|name|\n|1|vishnu|\n|2|prateek|\n")
@@ -766,3 +775,130 @@ func (s *MySuite) TestConceptHavingStaticParameters(c *C) {
 	_, parseRes := new(ConceptParser).Parse(conceptText)
 	c.Assert(parseRes.Error.Message, Equals, "Concept heading can have only Dynamic Parameters")
 }
+
+func (s *MySuite) TestConceptWithTextBlock(c *C) {
+	conceptText := SpecBuilder().
+		specHeading("create user <name>").
+		step("post request").
+		text("```json").
+		text("{\"name\": \"foo\"}").
+		text("```").
+		String()
+
+	concepts, parseRes := new(ConceptParser).Parse(conceptText)
+
+	c.Assert(parseRes.Error, IsNil)
+	step := concepts[0].ConceptSteps[0]
+	c.Assert(step.Value, Equals, "post request {}")
+	c.Assert(step.Args[0].ArgType, Equals, TextBlock)
+	c.Assert(step.Args[0].Value, Equals, "{\"name\": \"foo\"}")
+}
+
+func (s *MySuite) TestParsingConceptWithDefaultValues(c *C) {
+	conceptText := SpecBuilder().
+		specHeading("login as <user> with role <role> on <site>").
+		tableHeader("role", "site").
+		tableRow("admin", "staging").
+		step("login <user> <role> <site>").String()
+
+	concepts, parseRes := new(ConceptParser).Parse(conceptText)
+
+	c.Assert(parseRes.Error, IsNil)
+	concept := concepts[0]
+	c.Assert(concept.DefaultArgCount, Equals, 2)
+	c.Assert(concept.getArg("role").Value, Equals, "admin")
+	c.Assert(concept.getArg("site").Value, Equals, "staging")
+	c.Assert(concept.getArg("user"), IsNil)
+	c.Assert(concept.Items[1].Kind(), Equals, DataTableKind)
+	c.Assert(partialStepValues(concept), DeepEquals, []string{"login as {}", "login as {} with role {}"})
+}
+
+func (s *MySuite) TestErrorParsingConceptWithInvalidDefaultValues(c *C) {
+	_, parseRes := new(ConceptParser).Parse("# login as <user> with <role>\n|name|\n|admin|\n* login\n")
+	c.Assert(parseRes.Error.Message, Equals, "Default value given for unknown parameter <name>")
+
+	_, parseRes = new(ConceptParser).Parse("# login as <user> with <role>\n|user|\n|bob|\n* login\n")
+	c.Assert(parseRes.Error.Message, Equals, "Only the trailing parameters of a concept can have default values")
+
+	_, parseRes = new(ConceptParser).Parse("# login as <user> with <role>\n|role|\n|admin|\n|guest|\n* login\n")
+	c.Assert(parseRes.Error.Message, Equals, "Table of default values can have only one row")
+
+	_, parseRes = new(ConceptParser).Parse("# login as <user> with <role>\n|role|\n* login\n")
+	c.Assert(parseRes.Error.Message, Equals, "Table of default values should have a row of values")
+}
+
+func (s *MySuite) TestConceptUsageOmittingParametersWithDefaultValues(c *C) {
+	conceptDictionary := new(ConceptDictionary)
+	conceptText := SpecBuilder().
+		specHeading("login as <user> with role <role>").
+		tableHeader("role").
+		tableRow("admin").
+		step("login <user> <role>").String()
+	concepts, _ := new(ConceptParser).Parse(conceptText)
+	c.Assert(conceptDictionary.Add(concepts, "file.cpt"), IsNil)
+
+	specText := SpecBuilder().specHeading("A spec heading").
+		scenarioHeading("First flow").
+		step("login as \"bob\"").
+		step("login as \"alice\" with role \"guest\"").String()
+	spec, parseResult := new(SpecParser).Parse(specText, conceptDictionary)
+
+	c.Assert(parseResult.Ok, Equals, true)
+	withDefault := spec.Scenarios[0].Steps[0]
+	c.Assert(withDefault.IsConcept, Equals, true)
+	c.Assert(withDefault.Value, Equals, "login as {}")
+	c.Assert(len(withDefault.Args), Equals, 1)
+	c.Assert(withDefault.getArg("user").Value, Equals, "bob")
+	c.Assert(withDefault.getArg("role").Value, Equals, "admin")
+
+	PopulateConceptDynamicParams(withDefault, new(ArgLookup))
+	params := new(ParamResolver).GetResolvedParams(withDefault.ConceptSteps[0], withDefault, new(ArgLookup))
+	c.Assert(params[0].GetValue(), Equals, "bob")
+	c.Assert(params[1].GetValue(), Equals, "admin")
+
+	withoutDefault := spec.Scenarios[0].Steps[1]
+	c.Assert(withoutDefault.IsConcept, Equals, true)
+	c.Assert(withoutDefault.getArg("role").Value, Equals, "guest")
+}
+
+func (s *MySuite) TestNestedConceptUsageOmittingParametersWithDefaultValues(c *C) {
+	conceptDictionary := new(ConceptDictionary)
+	conceptText := SpecBuilder().
+		specHeading("create admin <name>").
+		step("create user <name>").
+		specHeading("create user <name> with role <role>").
+		tableHeader("role").
+		tableRow("guest").
+		step("add user <name> <role>").String()
+	concepts, parseRes := new(ConceptParser).Parse(conceptText)
+	c.Assert(parseRes.Error, IsNil)
+
+	c.Assert(conceptDictionary.Add(concepts, "file.cpt"), IsNil)
+
+	specText := SpecBuilder().specHeading("A spec heading").
+		scenarioHeading("First flow").
+		step("create admin \"bob\"").String()
+	spec, parseResult := new(SpecParser).Parse(specText, conceptDictionary)
+
+	c.Assert(parseResult.Ok, Equals, true)
+	nestedConcept := spec.Scenarios[0].Steps[0].ConceptSteps[0]
+	c.Assert(nestedConcept.IsConcept, Equals, true)
+	c.Assert(nestedConcept.getArg("name").Value, Equals, "bob")
+	c.Assert(nestedConcept.getArg("role").Value, Equals, "guest")
+}
+
+func (s *MySuite) TestErrorOnAmbiguousConceptsWithDefaultValues(c *C) {
+	conceptText := SpecBuilder().
+		specHeading("login as <user>").
+		step("login <user>").
+		specHeading("login as <user> with role <role>").
+		tableHeader("role").
+		tableRow("admin").
+		step("login <user> <role>").String()
+	concepts, _ := new(ConceptParser).Parse(conceptText)
+
+	err := new(ConceptDictionary).Add(concepts, "file.cpt")
+
+	c.Assert(err, NotNil)
+	c.Assert(err.Message, Equals, "Ambiguous concept definition found, \"login as <user> with role <role>\" and \"login as <user>\" match the same steps")
+}
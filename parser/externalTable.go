@@ -0,0 +1,284 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/util"
+)
+
+// tableReaders read the rows of external tables by file extension, the first row holds the headers.
+// Files with any other extension are read as CSV.
+var tableReaders = map[string]func(string) ([][]string, error){
+	".csv":      readCsvTable,
+	".tsv":      readTsvTable,
+	".json":     readJSONTable,
+	".md":       readMarkdownTable,
+	".markdown": readMarkdownTable,
+}
+
+// resolveExternalTable reads the table a reference like "users.json | select name, role | where role=admin" points to.
+// Clauses are applied in order, "select" keeps the given columns and "where" keeps the rows with column=value or column!=value,
+// split at the first "=". Every "|" starts a clause, so neither the file path nor the values can contain one.
+func resolveExternalTable(reference string) (*Table, error) {
+	clauses := strings.Split(reference, "|")
+	filePath := strings.TrimSpace(clauses[0])
+	contents, err := common.ReadFileContents(util.GetPathToFile(filePath))
+	if err != nil {
+		return nil, err
+	}
+	readTable, ok := tableReaders[strings.ToLower(filepath.Ext(filePath))]
+	if !ok {
+		readTable = readCsvTable
+	}
+	rows, err := readTable(contents)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filePath, err.Error())
+	}
+	for _, clause := range clauses[1:] {
+		rows, err = applyTableClause(rows, strings.TrimSpace(clause))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", filePath, err.Error())
+		}
+	}
+	return tableFromRows(rows), nil
+}
+
+func applyTableClause(rows [][]string, clause string) ([][]string, error) {
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("Cannot apply '%s' to a table without headers", clause)
+	}
+	keyword := strings.ToLower(strings.SplitN(clause, " ", 2)[0])
+	expression := strings.TrimSpace(clause[len(keyword):])
+	switch keyword {
+	case "select":
+		return selectColumns(rows, strings.Split(expression, ","))
+	case "where":
+		return filterRows(rows, expression)
+	}
+	return nil, fmt.Errorf("Unknown table clause '%s', expected select or where", clause)
+}
+
+func selectColumns(rows [][]string, columns []string) ([][]string, error) {
+	indices := make([]int, 0)
+	for _, column := range columns {
+		index := columnIndex(rows[0], strings.TrimSpace(column))
+		if index == -1 {
+			return nil, fmt.Errorf("Table column %s not found", strings.TrimSpace(column))
+		}
+		indices = append(indices, index)
+	}
+	selectedRows := make([][]string, 0)
+	for _, row := range rows {
+		selectedRow := make([]string, 0)
+		for _, index := range indices {
+			selectedRow = append(selectedRow, cellAt(row, index))
+		}
+		selectedRows = append(selectedRows, selectedRow)
+	}
+	return selectedRows, nil
+}
+
+func filterRows(rows [][]string, condition string) ([][]string, error) {
+	separator := strings.Index(condition, "=")
+	if separator == -1 {
+		return nil, fmt.Errorf("Invalid where clause '%s', expected column=value or column!=value", condition)
+	}
+	operator := "="
+	column, value := condition[:separator], strings.TrimSpace(condition[separator+1:])
+	if strings.HasSuffix(column, "!") {
+		operator = "!="
+		column = strings.TrimSuffix(column, "!")
+	}
+	column = strings.TrimSpace(column)
+	index := columnIndex(rows[0], column)
+	if index == -1 {
+		return nil, fmt.Errorf("Table column %s not found", column)
+	}
+	filteredRows := [][]string{rows[0]}
+	for _, row := range rows[1:] {
+		if (strings.TrimSpace(cellAt(row, index)) == value) == (operator == "=") {
+			filteredRows = append(filteredRows, row)
+		}
+	}
+	return filteredRows, nil
+}
+
+func columnIndex(headers []string, column string) int {
+	for i, header := range headers {
+		if strings.TrimSpace(header) == column {
+			return i
+		}
+	}
+	return -1
+}
+
+func cellAt(row []string, index int) string {
+	if index < len(row) {
+		return row[index]
+	}
+	return ""
+}
+
+func readCsvTable(contents string) ([][]string, error) {
+	r := csv.NewReader(strings.NewReader(contents))
+	r.Comment = '#'
+	return r.ReadAll()
+}
+
+// readTsvTable reads tab separated values, which unlike CSV have no quoting.
+func readTsvTable(contents string) ([][]string, error) {
+	rows := make([][]string, 0)
+	for _, line := range strings.Split(strings.Replace(contents, "\r\n", "\n", -1), "\n") {
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rows = append(rows, strings.Split(line, "\t"))
+	}
+	return rows, nil
+}
+
+// readJSONTable reads an array of objects. Columns are the keys in the order they first appear, nested values
+// are kept as JSON and numbers as they are written.
+func readJSONTable(contents string) ([][]string, error) {
+	var objects []json.RawMessage
+	if err := json.Unmarshal([]byte(contents), &objects); err != nil {
+		return nil, fmt.Errorf("JSON table should be an array of objects. %s", err.Error())
+	}
+	headers := make([]string, 0)
+	records := make([]map[string]string, 0)
+	for _, object := range objects {
+		keys, record, err := readJSONObject(object)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			if columnIndex(headers, key) == -1 {
+				headers = append(headers, key)
+			}
+		}
+		records = append(records, record)
+	}
+	rows := [][]string{headers}
+	for _, record := range records {
+		row := make([]string, 0)
+		for _, header := range headers {
+			row = append(row, record[header])
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func readJSONObject(object json.RawMessage) ([]string, map[string]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(object))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return nil, nil, fmt.Errorf("JSON table should be an array of objects")
+	}
+	keys := make([]string, 0)
+	record := make(map[string]string)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+		key := token.(string)
+		keys = append(keys, key)
+		record[key] = jsonCellValue(value)
+	}
+	return keys, record, nil
+}
+
+func jsonCellValue(value json.RawMessage) string {
+	var text string
+	if json.Unmarshal(value, &text) == nil {
+		return text
+	}
+	if string(value) == "null" {
+		return ""
+	}
+	var compacted bytes.Buffer
+	if json.Compact(&compacted, value) != nil {
+		return string(value)
+	}
+	return compacted.String()
+}
+
+// readMarkdownTable reads the first table of a markdown file.
+func readMarkdownTable(contents string) ([][]string, error) {
+	rows := make([][]string, 0)
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "|") {
+			if len(rows) > 0 {
+				break
+			}
+			continue
+		}
+		cells := splitMarkdownRow(line)
+		if len(rows) == 1 && isMarkdownSeparatorRow(cells) {
+			continue
+		}
+		rows = append(rows, cells)
+	}
+	return rows, nil
+}
+
+func splitMarkdownRow(line string) []string {
+	line = strings.TrimSuffix(strings.TrimPrefix(line, "|"), "|")
+	cells := make([]string, 0)
+	var cell bytes.Buffer
+	escaped := false
+	for _, element := range line {
+		if escaped {
+			if element != '|' {
+				cell.WriteRune('\\')
+			}
+			cell.WriteRune(element)
+			escaped = false
+		} else if element == '\\' {
+			escaped = true
+		} else if element == '|' {
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		} else {
+			cell.WriteRune(element)
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+func isMarkdownSeparatorRow(cells []string) bool {
+	for _, cell := range cells {
+		if strings.Trim(cell, ":-") != "" || !strings.Contains(cell, "-") {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,102 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func (s *MySuite) TestReadJSONTable(c *C) {
+	rows, err := readJSONTable(`[{"name": "foo", "age": 30}, {"name": "bar", "address": {"city": "x"}, "age": 4.50, "nick": null}]`)
+
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, [][]string{
+		{"name", "age", "address", "nick"},
+		{"foo", "30", "", ""},
+		{"bar", "4.50", `{"city":"x"}`, ""},
+	})
+}
+
+func (s *MySuite) TestReadJSONTableWhichIsNotAnArrayOfObjects(c *C) {
+	_, err := readJSONTable(`[1, 2]`)
+
+	c.Assert(err.Error(), Equals, "JSON table should be an array of objects")
+}
+
+func (s *MySuite) TestReadMarkdownTable(c *C) {
+	rows, err := readMarkdownTable("# Users\n\n| id | name |\n|:---|-----:|\n| 1 | foo \\| bar |\n|2|baz|\n\nsome text\n|3|ignored|")
+
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, [][]string{{"id", "name"}, {"1", "foo | bar"}, {"2", "baz"}})
+}
+
+func (s *MySuite) TestReadTsvTable(c *C) {
+	rows, err := readTsvTable("id\tname\n1\t\"foo\" bar\n")
+
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, [][]string{{"id", "name"}, {"1", "\"foo\" bar"}})
+}
+
+func (s *MySuite) TestResolveExternalTableWithClauses(c *C) {
+	dir, _ := ioutil.TempDir("", "externalTable")
+	defer os.RemoveAll(dir)
+	config.ProjectRoot = dir
+	ioutil.WriteFile(filepath.Join(dir, "users.json"), []byte(`[{"id": 1, "name": "foo", "role": "admin"}, {"id": 2, "name": "bar", "role": "dev"}, {"id": 3, "name": "Baz", "role": "admin"}]`), 0644)
+
+	table, err := resolveExternalTable("users.json | where role=admin | select name, id")
+
+	c.Assert(err, IsNil)
+	c.Assert(table.Headers, DeepEquals, []string{"name", "id"})
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"foo", "1"}, {"Baz", "3"}})
+
+	table, err = resolveExternalTable("users.json | where role != admin")
+	c.Assert(err, IsNil)
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"2", "bar", "dev"}})
+
+	ioutil.WriteFile(filepath.Join(dir, "notes.csv"), []byte("id,note\n1,a!=b\n2,a\n"), 0644)
+	table, err = resolveExternalTable("notes.csv | where note=a!=b")
+	c.Assert(err, IsNil)
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"1", "a!=b"}})
+
+	_, err = resolveExternalTable("users.json | where role")
+	c.Assert(err.Error(), Equals, "users.json: Invalid where clause 'role', expected column=value or column!=value")
+
+	_, err = resolveExternalTable("users.json | select email")
+	c.Assert(err.Error(), Equals, "users.json: Table column email not found")
+
+	_, err = resolveExternalTable("users.json | sort name")
+	c.Assert(err.Error(), Equals, "users.json: Unknown table clause 'sort name', expected select or where")
+}
+
+func (s *MySuite) TestSpecWithFilteredExternalDataTable(c *C) {
+	dir, _ := ioutil.TempDir("", "externalTable")
+	defer os.RemoveAll(dir)
+	config.ProjectRoot = dir
+	ioutil.WriteFile(filepath.Join(dir, "Users.md"), []byte("|name|role|\n|---|---|\n|foo|Admin|\n|bar|dev|\n"), 0644)
+	specText := SpecBuilder().specHeading("Spec").text("Table: Users.md | where role=Admin").scenarioHeading("Scenario").step("create <name>").String()
+
+	spec, result := new(SpecParser).Parse(specText, new(ConceptDictionary))
+
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(spec.DataTable.IsExternal, Equals, true)
+	c.Assert(spec.DataTable.Table.Rows(), DeepEquals, [][]string{{"foo", "Admin"}})
+}
@@ -0,0 +1,139 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+const frontMatterDelimiter = "---"
+
+// FrontMatter is the metadata at the top of a spec, placed between two --- lines.
+// Entries follow the flat subset of YAML: `key: value`, `key: [value1, value2]`, or a key followed by `- value` lines.
+type FrontMatter struct {
+	LineNo   int
+	Lines    []string
+	Metadata []*Metadata
+}
+
+type Metadata struct {
+	Key    string
+	Values []string
+	LineNo int
+}
+
+func (frontMatter *FrontMatter) Kind() TokenKind {
+	return FrontMatterKind
+}
+
+// Get returns the values of the given key, the key is matched case insensitively.
+func (frontMatter *FrontMatter) Get(key string) ([]string, bool) {
+	if frontMatter == nil {
+		return nil, false
+	}
+	for _, metadata := range frontMatter.Metadata {
+		if strings.EqualFold(metadata.Key, key) {
+			return metadata.Values, true
+		}
+	}
+	return nil, false
+}
+
+func (parser *SpecParser) isFrontMatterStart(text string) bool {
+	return parser.lineNo == 1 && text == frontMatterDelimiter
+}
+
+// readFrontMatter reads the lines up to the closing delimiter, the lines of the block are the args of the token.
+func (parser *SpecParser) readFrontMatter(startLine string) (*Token, *ParseError) {
+	startLineNo := parser.lineNo
+	blockLines := []string{startLine}
+	entryLines := make([]string, 0)
+	for line, hasLine := parser.nextLine(); hasLine; line, hasLine = parser.nextLine() {
+		blockLines = append(blockLines, line)
+		if strings.TrimSpace(line) == frontMatterDelimiter {
+			token := &Token{Kind: FrontMatterKind, LineNo: startLineNo, LineText: strings.Join(blockLines, "\n"), Args: entryLines}
+			token.Span = Span{StartLine: startLineNo, StartColumn: 1, EndLine: parser.lineNo, EndColumn: len(frontMatterDelimiter) + 1}
+			return token, nil
+		}
+		entryLines = append(entryLines, line)
+	}
+	return nil, &ParseError{LineNo: startLineNo, LineText: startLine, Message: "Front-matter is not closed"}
+}
+
+func processFrontMatter(parser *SpecParser, token *Token) (*ParseError, bool) {
+	parser.clearState()
+	return nil, false
+}
+
+func createFrontMatter(token *Token) (*FrontMatter, *ParseResult) {
+	frontMatter := &FrontMatter{LineNo: token.LineNo, Lines: token.Args, Metadata: make([]*Metadata, 0)}
+	var listEntry *Metadata
+	for i, line := range token.Args {
+		lineNo := token.LineNo + i + 1
+		trimmedLine := strings.TrimSpace(line)
+		if trimmedLine == "" || strings.HasPrefix(trimmedLine, "#") {
+			continue
+		}
+		if trimmedLine == "-" || strings.HasPrefix(trimmedLine, "- ") {
+			if listEntry == nil {
+				return nil, frontMatterError(lineNo, line, "List item is not part of a front-matter entry")
+			}
+			listEntry.Values = append(listEntry.Values, unquoteMetadataValue(strings.TrimSpace(trimmedLine[1:])))
+			continue
+		}
+		listEntry = nil
+		if strings.TrimLeft(line, " \t") != line {
+			return nil, frontMatterError(lineNo, line, "Nested front-matter entries are not supported")
+		}
+		separator := strings.Index(trimmedLine, ":")
+		if separator <= 0 {
+			return nil, frontMatterError(lineNo, line, "Front-matter entry should be of the form 'key: value'")
+		}
+		key := strings.TrimSpace(trimmedLine[:separator])
+		if _, ok := frontMatter.Get(key); ok {
+			return nil, frontMatterError(lineNo, line, fmt.Sprintf("Duplicate front-matter key '%s'", key))
+		}
+		metadata := &Metadata{Key: key, Values: make([]string, 0), LineNo: lineNo}
+		value := strings.TrimSpace(trimmedLine[separator+1:])
+		if value == "" {
+			listEntry = metadata
+		} else if strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+			for _, listValue := range strings.Split(value[1:len(value)-1], ",") {
+				if listValue = strings.TrimSpace(listValue); listValue != "" {
+					metadata.Values = append(metadata.Values, unquoteMetadataValue(listValue))
+				}
+			}
+		} else {
+			metadata.Values = append(metadata.Values, unquoteMetadataValue(value))
+		}
+		frontMatter.Metadata = append(frontMatter.Metadata, metadata)
+	}
+	return frontMatter, &ParseResult{Ok: true}
+}
+
+func unquoteMetadataValue(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+func frontMatterError(lineNo int, line string, message string) *ParseResult {
+	return &ParseResult{Ok: false, ParseError: &ParseError{LineNo: lineNo, LineText: line, Message: message}}
+}
@@ -0,0 +1,89 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestSpecWithFrontMatter(c *C) {
+	specText := `---
+owner: payments team
+jira: [PAY-12, "PAY-13"]
+# comments are ignored
+links:
+  - https://wiki/checkout
+  - 'https://wiki/refunds'
+---
+Spec heading
+============
+Scenario
+--------
+* do something
+`
+	spec, result := new(SpecParser).Parse(specText, new(ConceptDictionary))
+
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(spec.Heading.Value, Equals, "Spec heading")
+	c.Assert(spec.Heading.LineNo, Equals, 9)
+	c.Assert(spec.FrontMatter.LineNo, Equals, 1)
+	c.Assert(len(spec.FrontMatter.Lines), Equals, 6)
+	c.Assert(len(spec.FrontMatter.Metadata), Equals, 3)
+	c.Assert(spec.FrontMatter.Metadata[0].Key, Equals, "owner")
+	c.Assert(spec.FrontMatter.Metadata[0].Values, DeepEquals, []string{"payments team"})
+	c.Assert(spec.FrontMatter.Metadata[0].LineNo, Equals, 2)
+	c.Assert(spec.FrontMatter.Metadata[1].Values, DeepEquals, []string{"PAY-12", "PAY-13"})
+	links, ok := spec.FrontMatter.Get("Links")
+	c.Assert(ok, Equals, true)
+	c.Assert(links, DeepEquals, []string{"https://wiki/checkout", "https://wiki/refunds"})
+}
+
+func (s *MySuite) TestUnclosedFrontMatter(c *C) {
+	_, result := new(SpecParser).Parse("---\nowner: payments\nSpec heading\n============\n", new(ConceptDictionary))
+
+	c.Assert(result.Ok, Equals, false)
+	c.Assert(result.ParseError.Message, Equals, "Front-matter is not closed")
+	c.Assert(result.ParseError.LineNo, Equals, 1)
+}
+
+func (s *MySuite) TestInvalidFrontMatterEntries(c *C) {
+	_, result := new(SpecParser).Parse("---\nowner: payments\njira\n---\nSpec heading\n============\n", new(ConceptDictionary))
+	c.Assert(result.Ok, Equals, false)
+	c.Assert(result.ParseError.Message, Equals, "Front-matter entry should be of the form 'key: value'")
+	c.Assert(result.ParseError.LineNo, Equals, 3)
+
+	_, result = new(SpecParser).Parse("---\nowner: payments\nowner: search\n---\nSpec heading\n============\n", new(ConceptDictionary))
+	c.Assert(result.ParseError.Message, Equals, "Duplicate front-matter key 'owner'")
+
+	_, result = new(SpecParser).Parse("---\nowner:\n  name: payments\n---\nSpec heading\n============\n", new(ConceptDictionary))
+	c.Assert(result.ParseError.Message, Equals, "Nested front-matter entries are not supported")
+
+	_, result = new(SpecParser).Parse("---\n- payments\n---\nSpec heading\n============\n", new(ConceptDictionary))
+	c.Assert(result.ParseError.Message, Equals, "List item is not part of a front-matter entry")
+}
+
+func (s *MySuite) TestConvertFrontMatterToProtoSpec(c *C) {
+	spec := &Specification{Heading: &Heading{Value: "Spec heading"},
+		FrontMatter: &FrontMatter{Metadata: []*Metadata{&Metadata{Key: "component", Values: []string{"checkout"}}}}}
+
+	protoSpec := ConvertToProtoSpec(spec)
+
+	c.Assert(len(protoSpec.GetMetadata()), Equals, 1)
+	c.Assert(protoSpec.GetMetadata()[0].GetKey(), Equals, "component")
+	c.Assert(protoSpec.GetMetadata()[0].GetValues(), DeepEquals, []string{"checkout"})
+}
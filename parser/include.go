@@ -0,0 +1,142 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/util"
+)
+
+// Include inlines the context and teardown steps of a shared .ctx file into a spec.
+// A context file holds steps, optionally followed by a teardown separator and teardown steps.
+type Include struct {
+	LineNo        int
+	Value         string
+	LineText      string
+	ContextSteps  []*Step
+	TearDownSteps []*Step
+}
+
+func (include *Include) Kind() TokenKind {
+	return IncludeKind
+}
+
+func (parser *SpecParser) isInclude(text string) (string, bool) {
+	includeColon := "include:"
+	includeSpaceColon := "include :"
+	if strings.HasPrefix(strings.ToLower(text), includeColon) {
+		return strings.TrimSpace(text[len(includeColon):]), true
+	} else if strings.HasPrefix(strings.ToLower(text), includeSpaceColon) {
+		return strings.TrimSpace(text[len(includeSpaceColon):]), true
+	}
+	return "", false
+}
+
+func processInclude(parser *SpecParser, token *Token) (*ParseError, bool) {
+	parser.clearState()
+	if len(token.Value) == 0 {
+		return &ParseError{LineNo: parser.lineNo, LineText: token.LineText, Message: "Context file location not specified"}, true
+	}
+	return nil, false
+}
+
+func (spec *Specification) createInclude(token *Token) (*Include, *ParseResult) {
+	include := &Include{LineNo: token.LineNo, Value: token.Value, LineText: token.LineText}
+	fileName := util.GetPathToFile(include.Value)
+	content, err := common.ReadFileContents(fileName)
+	if err != nil {
+		return nil, &ParseResult{Ok: false, ParseError: &ParseError{token.LineNo, fmt.Sprintf("Could not read context file %s", include.Value), token.LineText}}
+	}
+	tokens, parseError := new(SpecParser).GenerateTokens(content)
+	if parseError != nil {
+		return nil, include.parseError(token, parseError.LineNo, parseError.Message)
+	}
+
+	result := &ParseResult{Ok: true}
+	isTearDown := false
+	var latestStep *Step
+	for _, ctxToken := range tokens {
+		switch ctxToken.Kind {
+		case CommentKind:
+			latestStep = nil
+		case TearDownKind:
+			isTearDown = true
+			latestStep = nil
+		case StepKind:
+			step, parseDetails := spec.createStep(ctxToken)
+			if parseDetails != nil && parseDetails.Error != nil {
+				return nil, include.parseError(token, ctxToken.LineNo, parseDetails.Error.Message)
+			}
+			step.FileName = fileName
+			if isTearDown {
+				include.TearDownSteps = append(include.TearDownSteps, step)
+			} else {
+				include.ContextSteps = append(include.ContextSteps, step)
+			}
+			include.addWarnings(result, parseDetails.Warnings)
+			latestStep = step
+		case TableHeader, TableRow:
+			if latestStep == nil {
+				return nil, include.parseError(token, ctxToken.LineNo, "Table not associated with a step")
+			}
+			if ctxToken.Kind == TableHeader {
+				addInlineTableHeader(latestStep, ctxToken)
+			} else if !areUnderlined(ctxToken.Args) {
+				include.addWarnings(result, addInlineTableRow(latestStep, ctxToken, new(ArgLookup).fromDataTable(&spec.DataTable.Table)).Warnings)
+			}
+		case TextBlockKind:
+			if latestStep == nil || latestStep.HasInlineTable || latestStep.HasTextBlock {
+				return nil, include.parseError(token, ctxToken.LineNo, "Text block not associated with a step")
+			}
+			addTextBlock(latestStep, ctxToken)
+		default:
+			return nil, include.parseError(token, ctxToken.LineNo, "Context file can only have steps, comments and a teardown")
+		}
+	}
+	return include, result
+}
+
+func (include *Include) parseError(token *Token, lineNo int, message string) *ParseResult {
+	message = fmt.Sprintf("%s in context file %s at line %d", message, include.Value, lineNo)
+	return &ParseResult{Ok: false, ParseError: &ParseError{token.LineNo, message, token.LineText}}
+}
+
+// addWarnings reports the warnings of the context file against the include line of the spec
+func (include *Include) addWarnings(result *ParseResult, warnings []*Warning) {
+	for _, warning := range warnings {
+		message := fmt.Sprintf("%s in context file %s at line %d", warning.Message, include.Value, warning.LineNo)
+		result.Warnings = append(result.Warnings, &Warning{Message: message, LineNo: include.LineNo})
+	}
+}
+
+func (specification *Specification) addInclude(include *Include) {
+	specification.Includes = append(specification.Includes, include)
+	specification.Contexts = append(specification.Contexts, include.ContextSteps...)
+	specification.addItem(include)
+}
+
+// addIncludedTearDownSteps runs the teardown steps of included context files after the
+// teardown steps of the spec, the last included file first.
+func (specification *Specification) addIncludedTearDownSteps() {
+	for i := len(specification.Includes) - 1; i >= 0; i-- {
+		specification.TearDownSteps = append(specification.TearDownSteps, specification.Includes[i].TearDownSteps...)
+	}
+}
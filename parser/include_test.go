@@ -0,0 +1,110 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parser
+
+import (
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func (s *MySuite) TestSpecWithIncludedContextFile(c *C) {
+	dir, _ := ioutil.TempDir("", "include")
+	defer os.RemoveAll(dir)
+	config.ProjectRoot = dir
+	ioutil.WriteFile(filepath.Join(dir, "login.ctx"), []byte("* login as <user>\n\n* open dashboard\n     |id|\n     |--|\n     |1 |\n___\n* logout\n"), 0644)
+
+	specText := SpecBuilder().specHeading("A spec heading").
+		tableHeader("user").
+		tableHeader("admin").
+		text("include: login.ctx").
+		step("spec context").
+		scenarioHeading("First scenario").
+		step("do something").
+		text("___").
+		step("spec teardown").
+		String()
+
+	spec, result := new(SpecParser).Parse(specText, new(ConceptDictionary))
+
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(len(spec.Contexts), Equals, 3)
+	c.Assert(spec.Contexts[0].Value, Equals, "login as {}")
+	c.Assert(spec.Contexts[0].Args[0].ArgType, Equals, Dynamic)
+	c.Assert(spec.Contexts[0].FileName, Equals, filepath.Join(dir, "login.ctx"))
+	c.Assert(spec.Contexts[0].LineNo, Equals, 1)
+	c.Assert(spec.Contexts[1].LineNo, Equals, 3)
+	c.Assert(spec.Contexts[1].HasInlineTable, Equals, true)
+	c.Assert(spec.Contexts[2].Value, Equals, "spec context")
+	c.Assert(spec.Contexts[2].FileName, Equals, "")
+
+	c.Assert(len(spec.TearDownSteps), Equals, 2)
+	c.Assert(spec.TearDownSteps[0].Value, Equals, "spec teardown")
+	c.Assert(spec.TearDownSteps[1].Value, Equals, "logout")
+	c.Assert(spec.TearDownSteps[1].LineNo, Equals, 8)
+	c.Assert(spec.Includes[0].Value, Equals, "login.ctx")
+}
+
+func (s *MySuite) TestIncludeOfMissingContextFile(c *C) {
+	config.ProjectRoot = os.TempDir()
+	specText := SpecBuilder().specHeading("A spec heading").
+		text("include: missing.ctx").
+		scenarioHeading("First scenario").
+		step("do something").
+		String()
+
+	_, result := new(SpecParser).Parse(specText, new(ConceptDictionary))
+
+	c.Assert(result.Ok, Equals, false)
+	c.Assert(result.ParseError.Error(), Equals, "line no: 2, Could not read context file missing.ctx")
+}
+
+func (s *MySuite) TestContextFileWithScenario(c *C) {
+	dir, _ := ioutil.TempDir("", "include")
+	defer os.RemoveAll(dir)
+	config.ProjectRoot = dir
+	ioutil.WriteFile(filepath.Join(dir, "login.ctx"), []byte("* login\n## scenario\n"), 0644)
+
+	specText := SpecBuilder().specHeading("A spec heading").
+		text("include: login.ctx").
+		scenarioHeading("First scenario").
+		step("do something").
+		String()
+
+	_, result := new(SpecParser).Parse(specText, new(ConceptDictionary))
+
+	c.Assert(result.Ok, Equals, false)
+	c.Assert(result.ParseError.Error(), Equals, "line no: 2, Context file can only have steps, comments and a teardown in context file login.ctx at line 2")
+}
+
+func (s *MySuite) TestIncludeInsideScenarioIsIgnored(c *C) {
+	specText := SpecBuilder().specHeading("A spec heading").
+		scenarioHeading("First scenario").
+		step("do something").
+		text("include: login.ctx").
+		String()
+
+	spec, result := new(SpecParser).Parse(specText, new(ConceptDictionary))
+
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(len(result.Warnings), Equals, 1)
+	c.Assert(result.Warnings[0].String(), Equals, "line no: 4, Include should be placed before the first scenario, ignoring include")
+	c.Assert(len(spec.Contexts), Equals, 0)
+}
@@ -98,6 +98,8 @@ func CreateStepValue(step *Step) StepValue {
 			args = append(args, "table")
 		case SpecialString, SpecialTable:
 			args = append(args, arg.Name)
+		case TextBlock:
+			args = append(args, string(TextBlock))
 		}
 	}
 	stepValue.Args = args
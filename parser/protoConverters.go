@@ -21,6 +21,7 @@ import (
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/golang/protobuf/proto"
+	"strings"
 )
 
 func ConvertToProtoItem(item Item) *gauge_messages.ProtoItem {
@@ -35,6 +36,9 @@ func ConvertToProtoItem(item Item) *gauge_messages.ProtoItem {
 		return convertToProtoDataTableItem(item.(*DataTable))
 	case TagKind:
 		return convertToProtoTagItem(item.(*Tags))
+	case IncludeKind:
+		include := item.(*Include)
+		return convertToProtoCommentItem(&Comment{strings.TrimSpace(include.LineText), include.LineNo})
 	}
 	return nil
 }
@@ -74,14 +78,35 @@ func convertToProtoConcept(concept *Step) *gauge_messages.ProtoItem {
 }
 
 func convertToProtoStep(step *Step) *gauge_messages.ProtoStep {
-	return &gauge_messages.ProtoStep{ActualText: proto.String(step.LineText), ParsedText: proto.String(step.Value), Fragments: makeFragmentsCopy(step.Fragments)}
+	return &gauge_messages.ProtoStep{ActualText: proto.String(step.LineText), ParsedText: proto.String(step.Value), Fragments: makeFragmentsCopy(step.Fragments), Span: convertToProtoSpan(step.Span)}
 }
 
 func convertToProtoTags(tags *Tags) *gauge_messages.ProtoTags {
-	return &gauge_messages.ProtoTags{Tags: getAllTags(tags)}
+	return &gauge_messages.ProtoTags{Tags: getAllTags(tags), TagSpans: getTagSpans(tags), KeyValueTags: getKeyValueTags(tags)}
 
 }
 
+func getKeyValueTags(tags *Tags) []*gauge_messages.KeyValueTag {
+	keyValueTags := make([]*gauge_messages.KeyValueTag, 0)
+	for _, tag := range tags.Values {
+		if key, value, ok := SplitKeyValueTag(tag); ok {
+			keyValueTags = append(keyValueTags, &gauge_messages.KeyValueTag{Key: proto.String(key), Value: proto.String(value)})
+		}
+	}
+	return keyValueTags
+}
+
+func getMetadata(frontMatter *FrontMatter) []*gauge_messages.SpecMetadata {
+	if frontMatter == nil {
+		return nil
+	}
+	metadata := make([]*gauge_messages.SpecMetadata, 0)
+	for _, entry := range frontMatter.Metadata {
+		metadata = append(metadata, &gauge_messages.SpecMetadata{Key: proto.String(entry.Key), Values: entry.Values})
+	}
+	return metadata
+}
+
 func getAllTags(tags *Tags) []string {
 	allTags := make([]string, 0)
 	for _, tag := range tags.Values {
@@ -90,6 +115,34 @@ func getAllTags(tags *Tags) []string {
 	return allTags
 }
 
+func getTagSpans(tags *Tags) []*gauge_messages.Span {
+	if tags == nil {
+		return nil
+	}
+	return convertToProtoSpans(tags.Spans)
+}
+
+func convertToProtoSpan(span Span) *gauge_messages.Span {
+	if span.StartLine == 0 {
+		return nil
+	}
+	return &gauge_messages.Span{StartLine: proto.Int32(int32(span.StartLine)), StartColumn: proto.Int32(int32(span.StartColumn)), EndLine: proto.Int32(int32(span.EndLine)), EndColumn: proto.Int32(int32(span.EndColumn))}
+}
+
+// convertToProtoSpans keeps a span for every element, since the spans are matched to the elements by position.
+// Unknown spans are sent as zero spans, because repeated fields cannot hold nil.
+func convertToProtoSpans(spans []Span) []*gauge_messages.Span {
+	var protoSpans []*gauge_messages.Span
+	for _, span := range spans {
+		protoSpan := convertToProtoSpan(span)
+		if protoSpan == nil {
+			protoSpan = &gauge_messages.Span{StartLine: proto.Int32(0), StartColumn: proto.Int32(0), EndLine: proto.Int32(0), EndColumn: proto.Int32(0)}
+		}
+		protoSpans = append(protoSpans, protoSpan)
+	}
+	return protoSpans
+}
+
 func makeFragmentsCopy(fragments []*gauge_messages.Fragment) []*gauge_messages.Fragment {
 	copiedFragments := make([]*gauge_messages.Fragment, 0)
 	for _, fragment := range fragments {
@@ -109,15 +162,15 @@ func makeFragmentCopy(fragment *gauge_messages.Fragment) *gauge_messages.Fragmen
 func makeParameterCopy(parameter *gauge_messages.Parameter) *gauge_messages.Parameter {
 	switch parameter.GetParameterType() {
 	case gauge_messages.Parameter_Static:
-		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Static.Enum(), Value: proto.String(parameter.GetValue()), Name: proto.String(parameter.GetName())}
+		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Static.Enum(), Value: proto.String(parameter.GetValue()), Name: proto.String(parameter.GetName()), Span: makeSpanCopy(parameter.GetSpan())}
 	case gauge_messages.Parameter_Dynamic:
-		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Dynamic.Enum(), Value: proto.String(parameter.GetValue()), Name: proto.String(parameter.GetName())}
+		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Dynamic.Enum(), Value: proto.String(parameter.GetValue()), Name: proto.String(parameter.GetName()), Span: makeSpanCopy(parameter.GetSpan())}
 	case gauge_messages.Parameter_Table:
-		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Table.Enum(), Table: makeTableCopy(parameter.GetTable()), Name: proto.String(parameter.GetName())}
+		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Table.Enum(), Table: makeTableCopy(parameter.GetTable()), Name: proto.String(parameter.GetName()), Span: makeSpanCopy(parameter.GetSpan())}
 	case gauge_messages.Parameter_Special_String:
-		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Special_String.Enum(), Value: proto.String(parameter.GetValue()), Name: proto.String(parameter.GetName())}
+		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Special_String.Enum(), Value: proto.String(parameter.GetValue()), Name: proto.String(parameter.GetName()), Span: makeSpanCopy(parameter.GetSpan())}
 	case gauge_messages.Parameter_Special_Table:
-		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Special_Table.Enum(), Table: makeTableCopy(parameter.GetTable()), Name: proto.String(parameter.GetName())}
+		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Special_Table.Enum(), Table: makeTableCopy(parameter.GetTable()), Name: proto.String(parameter.GetName()), Span: makeSpanCopy(parameter.GetSpan())}
 	}
 	return parameter
 }
@@ -136,7 +189,18 @@ func makeTableCopy(table *gauge_messages.ProtoTable) *gauge_messages.ProtoTable
 
 func makeProtoTableRowCopy(tableRow *gauge_messages.ProtoTableRow) *gauge_messages.ProtoTableRow {
 	copiedCells := make([]string, 0)
-	return &gauge_messages.ProtoTableRow{Cells: append(copiedCells, tableRow.GetCells()...)}
+	copiedRow := &gauge_messages.ProtoTableRow{Cells: append(copiedCells, tableRow.GetCells()...)}
+	for _, span := range tableRow.GetCellSpans() {
+		copiedRow.CellSpans = append(copiedRow.CellSpans, makeSpanCopy(span))
+	}
+	return copiedRow
+}
+
+func makeSpanCopy(span *gauge_messages.Span) *gauge_messages.Span {
+	if span == nil {
+		return nil
+	}
+	return &gauge_messages.Span{StartLine: proto.Int32(span.GetStartLine()), StartColumn: proto.Int32(span.GetStartColumn()), EndLine: proto.Int32(span.GetEndLine()), EndColumn: proto.Int32(span.GetEndColumn())}
 }
 
 func convertToProtoSteps(steps []*Step) []*gauge_messages.ProtoStep {
@@ -166,24 +230,24 @@ func convertToProtoParameters(args []*StepArg) []*gauge_messages.Parameter {
 func convertToProtoParameter(arg *StepArg) *gauge_messages.Parameter {
 	switch arg.ArgType {
 	case Static:
-		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Static.Enum(), Value: proto.String(arg.Value), Name: proto.String(arg.Name)}
+		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Static.Enum(), Value: proto.String(arg.Value), Name: proto.String(arg.Name), Span: convertToProtoSpan(arg.Span)}
 	case Dynamic:
-		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Dynamic.Enum(), Value: proto.String(arg.Value), Name: proto.String(arg.Name)}
+		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Dynamic.Enum(), Value: proto.String(arg.Value), Name: proto.String(arg.Name), Span: convertToProtoSpan(arg.Span)}
 	case TableArg:
-		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Table.Enum(), Table: convertToProtoTableParam(&arg.Table), Name: proto.String(arg.Name)}
-	case SpecialString:
-		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Special_String.Enum(), Value: proto.String(arg.Value), Name: proto.String(arg.Name)}
+		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Table.Enum(), Table: convertToProtoTableParam(&arg.Table), Name: proto.String(arg.Name), Span: convertToProtoSpan(arg.Span)}
+	case SpecialString, TextBlock:
+		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Special_String.Enum(), Value: proto.String(arg.Value), Name: proto.String(arg.Name), Span: convertToProtoSpan(arg.Span)}
 	case SpecialTable:
-		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Special_Table.Enum(), Table: convertToProtoTableParam(&arg.Table), Name: proto.String(arg.Name)}
+		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Special_Table.Enum(), Table: convertToProtoTableParam(&arg.Table), Name: proto.String(arg.Name), Span: convertToProtoSpan(arg.Span)}
 	}
 	return nil
 }
 
 func convertToProtoTableParam(table *Table) *gauge_messages.ProtoTable {
 	protoTableParam := &gauge_messages.ProtoTable{Rows: make([]*gauge_messages.ProtoTableRow, 0)}
-	protoTableParam.Headers = &gauge_messages.ProtoTableRow{Cells: table.Headers}
-	for _, row := range table.Rows() {
-		protoTableParam.Rows = append(protoTableParam.Rows, &gauge_messages.ProtoTableRow{Cells: row})
+	protoTableParam.Headers = &gauge_messages.ProtoTableRow{Cells: table.Headers, CellSpans: convertToProtoSpans(table.HeaderSpans)}
+	for i, row := range table.Rows() {
+		protoTableParam.Rows = append(protoTableParam.Rows, &gauge_messages.ProtoTableRow{Cells: row, CellSpans: table.rowSpans(i)})
 	}
 	return protoTableParam
 }
@@ -257,11 +321,14 @@ func ConvertToProtoStepValue(stepValue *StepValue) *gauge_messages.ProtoStepValu
 
 func newProtoSpec(specification *Specification) *gauge_messages.ProtoSpec {
 	return &gauge_messages.ProtoSpec{
-		Items:         make([]*gauge_messages.ProtoItem, 0),
-		SpecHeading:   proto.String(specification.Heading.Value),
-		IsTableDriven: proto.Bool(false),
-		FileName:      proto.String(specification.FileName),
-		Tags:          getTags(specification.Tags),
+		Items:           make([]*gauge_messages.ProtoItem, 0),
+		SpecHeading:     proto.String(specification.Heading.Value),
+		IsTableDriven:   proto.Bool(false),
+		FileName:        proto.String(specification.FileName),
+		Tags:            getTags(specification.Tags),
+		SpecHeadingSpan: convertToProtoSpan(specification.Heading.Span),
+		TagSpans:        getTagSpans(specification.Tags),
+		Metadata:        getMetadata(specification.FrontMatter),
 	}
 
 }
@@ -275,11 +342,13 @@ func NewSpecResult(specification *Specification) *result.SpecResult {
 
 func NewProtoScenario(scenario *Scenario) *gauge_messages.ProtoScenario {
 	return &gauge_messages.ProtoScenario{
-		ScenarioHeading: proto.String(scenario.Heading.Value),
-		Failed:          proto.Bool(false),
-		Tags:            getTags(scenario.Tags),
-		Contexts:        make([]*gauge_messages.ProtoItem, 0),
-		ExecutionTime:   proto.Int64(0),
+		ScenarioHeading:     proto.String(scenario.Heading.Value),
+		Failed:              proto.Bool(false),
+		Tags:                getTags(scenario.Tags),
+		Contexts:            make([]*gauge_messages.ProtoItem, 0),
+		ExecutionTime:       proto.Int64(0),
+		ScenarioHeadingSpan: convertToProtoSpan(scenario.Heading.Span),
+		TagSpans:            getTagSpans(scenario.Tags),
 	}
 }
 
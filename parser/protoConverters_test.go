@@ -108,3 +108,47 @@ func compareTable(table1 *gauge_messages.ProtoTable, table2 *gauge_messages.Prot
 func compareTableRow(row1 *gauge_messages.ProtoTableRow, row2 *gauge_messages.ProtoTableRow, c *C) {
 	c.Assert(row1.GetCells(), DeepEquals, row2.GetCells())
 }
+
+func (s *MySuite) TestProtoSpecHasSourceSpans(c *C) {
+	specText := SpecBuilder().specHeading("Spec").tags("tag1").scenarioHeading("Scenario").step("say \"hi\"").tableHeader("a").tableRow("b").String()
+
+	spec, result := new(SpecParser).Parse(specText, new(ConceptDictionary))
+	c.Assert(result.Ok, Equals, true)
+	protoSpec := ConvertToProtoSpec(spec)
+
+	c.Assert(protoSpec.GetSpecHeadingSpan().GetStartLine(), Equals, int32(1))
+	c.Assert(protoSpec.GetTagSpans()[0].GetEndColumn(), Equals, int32(11))
+	protoScenario := spec.Scenarios[0]
+	c.Assert(NewProtoScenario(protoScenario).GetScenarioHeadingSpan().GetStartLine(), Equals, int32(3))
+	protoStep := convertToProtoStep(protoScenario.Steps[0])
+	c.Assert(protoStep.GetSpan().GetStartColumn(), Equals, int32(3))
+	staticParam := protoStep.GetFragments()[1].GetParameter()
+	c.Assert(staticParam.GetSpan().GetStartColumn(), Equals, int32(7))
+	c.Assert(staticParam.GetSpan().GetEndColumn(), Equals, int32(11))
+	tableParam := protoStep.GetFragments()[3].GetParameter()
+	c.Assert(tableParam.GetSpan().GetStartLine(), Equals, int32(5))
+	c.Assert(tableParam.GetSpan().GetEndLine(), Equals, int32(6))
+	c.Assert(tableParam.GetTable().GetHeaders().GetCellSpans()[0].GetStartColumn(), Equals, int32(2))
+	c.Assert(tableParam.GetTable().GetRows()[0].GetCellSpans()[0].GetStartLine(), Equals, int32(6))
+}
+
+func (s *MySuite) TestProtoTagsWithUnknownSpansCanBeMarshalled(c *C) {
+	tags := &Tags{Values: []string{"tag1", "tag2"}, Spans: []Span{Span{StartLine: 2, StartColumn: 7, EndLine: 2, EndColumn: 11}, Span{}}}
+
+	protoTags := convertToProtoTags(tags)
+
+	c.Assert(len(protoTags.GetTagSpans()), Equals, 2)
+	c.Assert(protoTags.GetTagSpans()[1].GetStartLine(), Equals, int32(0))
+	_, err := proto.Marshal(protoTags)
+	c.Assert(err, IsNil)
+}
+
+func (s *MySuite) TestConvertToProtoTagsWithKeyValueTags(c *C) {
+	protoTags := convertToProtoTags(&Tags{Values: []string{"smoke", "owner: payments", "url:http://host"}})
+
+	c.Assert(len(protoTags.GetKeyValueTags()), Equals, 2)
+	c.Assert(protoTags.GetKeyValueTags()[0].GetKey(), Equals, "owner")
+	c.Assert(protoTags.GetKeyValueTags()[0].GetValue(), Equals, "payments")
+	c.Assert(protoTags.GetKeyValueTags()[1].GetKey(), Equals, "url")
+	c.Assert(protoTags.GetKeyValueTags()[1].GetValue(), Equals, "http://host")
+}
@@ -18,13 +18,27 @@
 package parser
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/gauge_messages"
+	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/util"
 	"github.com/golang/protobuf/proto"
+	"os"
+	"os/exec"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+)
+
+var (
+	commandResolversOnce    sync.Once
+	projectCommandResolvers map[string]resolverFn
 )
 
 type invalidSpecialParamError struct {
@@ -63,11 +77,14 @@ func (paramResolver *ParamResolver) GetResolvedParams(step *Step, parent *Step,
 			if resolvedArg.Table.IsInitialized() {
 				parameter.ParameterType = gauge_messages.Parameter_Special_Table.Enum()
 				parameter.Table = paramResolver.createProtoStepTable(&resolvedArg.Table, dataTableLookup)
+			} else if resolvedArg.ArgType == TextBlock {
+				parameter.ParameterType = gauge_messages.Parameter_Special_String.Enum()
+				parameter.Value = proto.String(resolvedArg.Value)
 			} else {
 				parameter.ParameterType = gauge_messages.Parameter_Dynamic.Enum()
 				parameter.Value = proto.String(resolvedArg.Value)
 			}
-		} else if arg.ArgType == SpecialString {
+		} else if arg.ArgType == SpecialString || arg.ArgType == TextBlock {
 			parameter.ParameterType = gauge_messages.Parameter_Special_String.Enum()
 			parameter.Value = proto.String(arg.Value)
 		} else if arg.ArgType == SpecialTable {
@@ -117,6 +134,16 @@ func newSpecialTypeResolver() *specialTypeResolver {
 }
 
 func initializePredefinedResolvers() map[string]resolverFn {
+	resolvers := builtInResolvers()
+	for prefix, resolver := range commandResolvers() {
+		if _, ok := resolvers[prefix]; !ok {
+			resolvers[prefix] = resolver
+		}
+	}
+	return resolvers
+}
+
+func builtInResolvers() map[string]resolverFn {
 	return map[string]resolverFn{
 		"file": func(filePath string) (*StepArg, error) {
 			fileContent, err := common.ReadFileContents(util.GetPathToFile(filePath))
@@ -125,20 +152,167 @@ func initializePredefinedResolvers() map[string]resolverFn {
 			}
 			return &StepArg{Value: fileContent, ArgType: SpecialString}, nil
 		},
-		"table": func(filePath string) (*StepArg, error) {
-			csv, err := common.ReadFileContents(util.GetPathToFile(filePath))
+		"table": func(reference string) (*StepArg, error) {
+			table, err := resolveExternalTable(reference)
 			if err != nil {
 				return nil, err
 			}
-			csvTable, err := convertCsvToTable(csv)
+			return &StepArg{Table: *table, ArgType: SpecialTable}, nil
+		},
+		"env": func(name string) (*StepArg, error) {
+			value, ok := os.LookupEnv(name)
+			if !ok {
+				return nil, fmt.Errorf("Environment property %s is not set", name)
+			}
+			return &StepArg{Value: value, ArgType: SpecialString}, nil
+		},
+		"json": func(value string) (*StepArg, error) {
+			filePath, pointer := splitFragment(value)
+			content, err := common.ReadFileContents(util.GetPathToFile(filePath))
 			if err != nil {
 				return nil, err
 			}
-			return &StepArg{Table: *csvTable, ArgType: SpecialTable}, nil
+			jsonValue, err := resolveJSONPointer(content, pointer)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", filePath, err.Error())
+			}
+			return &StepArg{Value: jsonValue, ArgType: SpecialString}, nil
 		},
+		"text": func(value string) (*StepArg, error) {
+			filePath, lineRange := splitFragment(value)
+			content, err := common.ReadFileContents(util.GetPathToFile(filePath))
+			if err != nil {
+				return nil, err
+			}
+			text, err := selectLines(content, lineRange)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", filePath, err.Error())
+			}
+			return &StepArg{Value: text, ArgType: SpecialString}, nil
+		},
+	}
+}
+
+// commandResolvers reads the resolvers declared in the environment once, as the environment is loaded before
+// any spec is parsed.
+func commandResolvers() map[string]resolverFn {
+	commandResolversOnce.Do(func() {
+		projectCommandResolvers = readCommandResolvers()
+	})
+	return projectCommandResolvers
+}
+
+// readCommandResolvers makes resolvers of the commands the project declares, see util.ResolverCommands.
+func readCommandResolvers() map[string]resolverFn {
+	resolvers := make(map[string]resolverFn)
+	commands, errs := util.ResolverCommands()
+	for _, err := range errs {
+		logger.Warning("%s\n", err.Error())
+	}
+	for prefix, command := range commands {
+		resolvers[prefix] = commandResolver(command)
+	}
+	return resolvers
+}
+
+func commandResolver(command *util.ResolverCommand) resolverFn {
+	if command.IsTable {
+		return func(value string) (*StepArg, error) {
+			output, err := runResolverCommand(command.Args, value)
+			if err != nil {
+				return nil, err
+			}
+			table, err := convertCsvToTable(output)
+			if err != nil {
+				return nil, err
+			}
+			return &StepArg{Table: *table, ArgType: SpecialTable}, nil
+		}
+	}
+	return func(value string) (*StepArg, error) {
+		output, err := runResolverCommand(command.Args, value)
+		if err != nil {
+			return nil, err
+		}
+		return &StepArg{Value: strings.TrimRight(output, "\r\n"), ArgType: SpecialString}, nil
 	}
 }
 
+func runResolverCommand(command []string, value string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(command[0], append(command[1:], value)...)
+	cmd.Dir = config.ProjectRoot
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("Resolver command '%s' failed: %s %s", strings.Join(command, " "), err.Error(), strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
+
+func splitFragment(value string) (string, string) {
+	if i := strings.LastIndex(value, "#"); i != -1 {
+		return strings.TrimSpace(value[:i]), strings.TrimSpace(value[i+1:])
+	}
+	return value, ""
+}
+
+// resolveJSONPointer returns the value the RFC 6901 pointer refers to. Strings are returned as they are,
+// any other value as JSON.
+func resolveJSONPointer(content string, pointer string) (string, error) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return "", err
+	}
+	if pointer != "" {
+		if !strings.HasPrefix(pointer, "/") {
+			return "", fmt.Errorf("JSON pointer %s should start with '/'", pointer)
+		}
+		for _, token := range strings.Split(pointer[1:], "/") {
+			token = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+			switch node := document.(type) {
+			case map[string]interface{}:
+				value, ok := node[token]
+				if !ok {
+					return "", fmt.Errorf("JSON pointer %s not found", pointer)
+				}
+				document = value
+			case []interface{}:
+				index, err := strconv.Atoi(token)
+				if err != nil || index < 0 || index >= len(node) {
+					return "", fmt.Errorf("JSON pointer %s not found", pointer)
+				}
+				document = node[index]
+			default:
+				return "", fmt.Errorf("JSON pointer %s not found", pointer)
+			}
+		}
+	}
+	if value, ok := document.(string); ok {
+		return value, nil
+	}
+	value, err := json.Marshal(document)
+	return string(value), err
+}
+
+// selectLines returns the lines in the 1 based, inclusive range. Ranges are of the form 3 or 3-5.
+func selectLines(content string, lineRange string) (string, error) {
+	lines := strings.Split(strings.TrimSuffix(strings.Replace(content, "\r\n", "\n", -1), "\n"), "\n")
+	if lineRange == "" {
+		return content, nil
+	}
+	bounds := strings.SplitN(lineRange, "-", 2)
+	start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+	end := start
+	if err == nil && len(bounds) == 2 {
+		end, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+	}
+	if err != nil || start < 1 || end < start || end > len(lines) {
+		return "", fmt.Errorf("Invalid line range %s, the file has %d lines", lineRange, len(lines))
+	}
+	return strings.Join(lines[start-1:end], "\n"), nil
+}
+
 func (resolver *specialTypeResolver) resolve(arg string) (*StepArg, error) {
 	if util.IsWindows() {
 		arg = GetUnescapedString(arg)
@@ -159,7 +333,16 @@ func (resolver *specialTypeResolver) getStepArg(specialType string, value string
 	if found {
 		return resolveFunc(value)
 	}
-	return nil, invalidSpecialParamError{message: fmt.Sprintf("Resolver not found for special param <%s>", arg)}
+	return nil, invalidSpecialParamError{message: fmt.Sprintf("Resolver not found for special param <%s>. Available resolvers: %s", arg, strings.Join(resolver.availableResolvers(), ", "))}
+}
+
+func (resolver *specialTypeResolver) availableResolvers() []string {
+	var names []string
+	for name := range resolver.predefinedResolvers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 // Creating a copy of the lookup and populating table values
@@ -18,8 +18,15 @@
 package parser
 
 import (
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/util"
 	. "gopkg.in/check.v1"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
 )
 
 func (s *MySuite) TestParsingFileSpecialType(c *C) {
@@ -54,7 +61,82 @@ func (s *MySuite) TestParsingInvalidSpecialType(c *C) {
 	resolver := newSpecialTypeResolver()
 
 	_, err := resolver.resolve("unknown:foo")
-	c.Assert(err.Error(), Equals, "Resolver not found for special param <unknown:foo>")
+	c.Assert(err.Error(), Equals, "Resolver not found for special param <unknown:foo>. Available resolvers: env, file, json, table, text")
+}
+
+func (s *MySuite) TestParsingEnvSpecialType(c *C) {
+	os.Setenv("gauge_resolver_test_property", "bar")
+	defer os.Unsetenv("gauge_resolver_test_property")
+
+	stepArg, err := newSpecialTypeResolver().resolve("env:gauge_resolver_test_property")
+	c.Assert(err, IsNil)
+	c.Assert(stepArg.Value, Equals, "bar")
+	c.Assert(stepArg.ArgType, Equals, SpecialString)
+
+	_, err = newSpecialTypeResolver().resolve("env:gauge_resolver_unset_property")
+	c.Assert(err.Error(), Equals, "Environment property gauge_resolver_unset_property is not set")
+}
+
+func (s *MySuite) TestParsingJsonAndTextSpecialTypes(c *C) {
+	dir, _ := ioutil.TempDir("", "resolver")
+	defer os.RemoveAll(dir)
+	config.ProjectRoot = dir
+	ioutil.WriteFile(filepath.Join(dir, "data.json"), []byte(`{"users": [{"name": "foo"}, {"name": "bar", "a/b": 1}]}`), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "notes.txt"), []byte("one\ntwo\nthree\n"), 0644)
+
+	stepArg, err := newSpecialTypeResolver().resolve("json:data.json#/users/1/name")
+	c.Assert(err, IsNil)
+	c.Assert(stepArg.Value, Equals, "bar")
+
+	stepArg, err = newSpecialTypeResolver().resolve("json:data.json#/users/1/a~1b")
+	c.Assert(err, IsNil)
+	c.Assert(stepArg.Value, Equals, "1")
+
+	_, err = newSpecialTypeResolver().resolve("json:data.json#/users/2")
+	c.Assert(err.Error(), Equals, "data.json: JSON pointer /users/2 not found")
+
+	stepArg, err = newSpecialTypeResolver().resolve("text:notes.txt#2-3")
+	c.Assert(err, IsNil)
+	c.Assert(stepArg.Value, Equals, "two\nthree")
+
+	_, err = newSpecialTypeResolver().resolve("text:notes.txt#3-4")
+	c.Assert(err.Error(), Equals, "notes.txt: Invalid line range 3-4, the file has 3 lines")
+}
+
+func (s *MySuite) TestParsingSpecialTypeWithCommandResolver(c *C) {
+	if util.IsWindows() {
+		c.Skip("resolver commands are posix shell commands")
+	}
+	config.ProjectRoot = os.TempDir()
+	os.Setenv("special_param_resolver_greet", "echo \"hello  there\"")
+	os.Setenv("special_table_resolver_users", "printf 'id,name\\n1,%s'")
+	defer os.Unsetenv("special_param_resolver_greet")
+	defer os.Unsetenv("special_table_resolver_users")
+	commandResolversOnce = sync.Once{}
+	defer func() { commandResolversOnce = sync.Once{} }()
+
+	c.Assert(util.IsSpecialParamType("greet"), Equals, true)
+	stepArg, err := newSpecialTypeResolver().resolve("greet:world")
+	c.Assert(err, IsNil)
+	c.Assert(stepArg.Value, Equals, "hello  there world")
+	c.Assert(stepArg.ArgType, Equals, SpecialString)
+
+	stepArg, err = newSpecialTypeResolver().resolve("users:foo")
+	c.Assert(err, IsNil)
+	c.Assert(stepArg.ArgType, Equals, SpecialTable)
+	c.Assert(stepArg.Table.Get("name")[0].Value, Equals, "foo")
+}
+
+func (s *MySuite) TestBuiltInResolversAreTheBuiltInSpecialParamTypes(c *C) {
+	prefixes := make([]string, 0)
+	for prefix := range builtInResolvers() {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	paramTypes := append([]string{}, util.BuiltInSpecialParamTypes...)
+	sort.Strings(paramTypes)
+
+	c.Assert(prefixes, DeepEquals, paramTypes)
 }
 
 func (s *MySuite) TestConvertCsvToTable(c *C) {
@@ -78,7 +160,7 @@ func (s *MySuite) TestParsingUnknownSpecialType(c *C) {
 	resolver := newSpecialTypeResolver()
 
 	_, err := resolver.getStepArg("unknown", "foo", "unknown:foo")
-	c.Assert(err.Error(), Equals, "Resolver not found for special param <unknown:foo>")
+	c.Assert(err.Error(), Equals, "Resolver not found for special param <unknown:foo>. Available resolvers: env, file, json, table, text")
 }
 
 func (s *MySuite) TestPopulatingConceptLookup(c *C) {
@@ -271,3 +353,12 @@ func (s *MySuite) TestEachConceptUsageIsUpdatedWithRespectiveParamsIncludingDyna
 	c.Assert(nestedConcept3.getArg("h").Value, Equals, "c")
 	c.Assert(nestedConcept3.getArg("i").Value, Equals, "a")
 }
+
+func (s *MySuite) TestResolveTextBlockParam(c *C) {
+	step := &Step{Value: "post request {}", Args: []*StepArg{&StepArg{ArgType: TextBlock, Name: "json", Value: "{}"}}}
+
+	parameters := new(ParamResolver).GetResolvedParams(step, nil, new(ArgLookup))
+
+	c.Assert(parameters[0].GetParameterType(), Equals, gauge_messages.Parameter_Special_String)
+	c.Assert(parameters[0].GetValue(), Equals, "{}")
+}
@@ -21,16 +21,22 @@ import (
 	"fmt"
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/golang/protobuf/proto"
+	"os"
 	"regexp"
 	"strings"
 )
 
+// Set this project property to true to let a table right after a scenario heading drive that scenario.
+// Without it such a table is a comment.
+const allowScenarioDataTableProperty = "allow_scenario_datatable"
+
 type Scenario struct {
-	Heading  *Heading
-	Steps    []*Step
-	Comments []*Comment
-	Tags     *Tags
-	Items    []Item
+	Heading   *Heading
+	Steps     []*Step
+	Comments  []*Comment
+	Tags      *Tags
+	DataTable DataTable
+	Items     []Item
 }
 
 type ArgType string
@@ -41,6 +47,7 @@ const (
 	TableArg             ArgType = "table"
 	SpecialString        ArgType = "special_string"
 	SpecialTable         ArgType = "special_table"
+	TextBlock            ArgType = "text_block"
 	ParameterPlaceholder         = "{}"
 )
 
@@ -49,6 +56,7 @@ type StepArg struct {
 	Value   string
 	ArgType ArgType
 	Table   Table
+	Span    Span
 }
 
 func (stepArg *StepArg) String() string {
@@ -89,8 +97,13 @@ type Step struct {
 	Fragments      []*gauge_messages.Fragment
 	Parent         *Step
 	HasInlineTable bool
+	HasTextBlock   bool
 	Items          []Item
 	PreComments    []*Comment
+	Span           Span
+	FileName       string
+	// number of trailing parameters of a concept heading which have default values
+	DefaultArgCount int
 }
 
 type TearDown struct {
@@ -120,6 +133,9 @@ func (step *Step) getLineText() string {
 	if step.HasInlineTable {
 		return fmt.Sprintf("%s <%s>", step.LineText, TableArg)
 	}
+	if step.HasTextBlock {
+		return fmt.Sprintf("%s <%s>", step.LineText, TextBlock)
+	}
 	return step.LineText
 }
 
@@ -133,6 +149,10 @@ func (step *Step) Rename(oldStep Step, newStep Step, isRefactored bool, orderMap
 	step.Value = newStep.Value
 
 	step.Args = step.getArgsInOrder(newStep, orderMap)
+	step.HasTextBlock = false
+	for _, arg := range step.Args {
+		step.HasTextBlock = step.HasTextBlock || arg.ArgType == TextBlock
+	}
 	return true
 }
 
@@ -140,7 +160,7 @@ func (step *Step) getArgsInOrder(newStep Step, orderMap map[int]int) []*StepArg
 	args := make([]*StepArg, len(newStep.Args))
 	for key, value := range orderMap {
 		arg := &StepArg{Value: newStep.Args[key].Value, ArgType: Static}
-		if step.IsConcept {
+		if step.isConceptHeading() {
 			arg = &StepArg{Value: newStep.Args[key].Value, ArgType: Dynamic}
 		}
 		if value != -1 {
@@ -151,6 +171,11 @@ func (step *Step) getArgsInOrder(newStep Step, orderMap map[int]int) []*StepArg
 	return args
 }
 
+// isConceptHeading tells whether the step defines a concept, rather than uses one. The heading is the first item of its concept.
+func (step *Step) isConceptHeading() bool {
+	return step.IsConcept && len(step.Items) > 0 && step.Items[0] == step
+}
+
 func (step *Step) deepCopyStepArgs() []*StepArg {
 	copiedStepArgs := make([]*StepArg, 0)
 	for _, conceptStepArg := range step.Args {
@@ -224,6 +249,10 @@ type Specification struct {
 	Tags          *Tags
 	Items         []Item
 	TearDownSteps []*Step
+	Includes      []*Include
+	FrontMatter   *FrontMatter
+	// data table rows selected for this spec on the command line, e.g. specs/a.spec:rows=1-3
+	TableRows string
 }
 
 type Item interface {
@@ -241,6 +270,9 @@ type Heading struct {
 	Value       string
 	LineNo      int
 	HeadingType HeadingType
+	Span        Span
+	// markdown of the heading as written in the spec, either with # or underlined
+	LineText string
 }
 
 type Comment struct {
@@ -250,6 +282,35 @@ type Comment struct {
 
 type Tags struct {
 	Values []string
+	Spans  []Span
+}
+
+// add continues tags on the next line, so that long lists of tags can be wrapped.
+func (tags *Tags) add(next *Tags) {
+	tags.Values = append(tags.Values, next.Values...)
+	tags.Spans = append(tags.Spans, next.Spans...)
+}
+
+func isLastItem(items []Item, item Item) bool {
+	return len(items) > 0 && items[len(items)-1] == item
+}
+
+// SplitKeyValueTag splits a tag of the form key:value, like owner:payments, into its key and value.
+func SplitKeyValueTag(tag string) (string, string, bool) {
+	separator := strings.Index(tag, ":")
+	if separator <= 0 || separator == len(tag)-1 {
+		return "", "", false
+	}
+	return strings.TrimSpace(tag[:separator]), strings.TrimSpace(tag[separator+1:]), true
+}
+
+// Span is the range of an element in its source file. Lines and columns are 1 based,
+// columns count characters and EndColumn is exclusive.
+type Span struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
 }
 
 type Warning struct {
@@ -301,6 +362,7 @@ func (specParser *SpecParser) CreateSpecification(tokens []*Token, conceptDictio
 		}
 	}
 
+	specification.addIncludedTearDownSteps()
 	specification.processConceptStepsFrom(conceptDictionary)
 	validationError := specParser.validateSpec(specification)
 	if validationError != nil {
@@ -313,6 +375,7 @@ func (specParser *SpecParser) CreateSpecification(tokens []*Token, conceptDictio
 }
 
 func (specParser *SpecParser) initializeConverters() []func(*Token, *int, *Specification) ParseResult {
+	scenarioDataTableAllowed := strings.ToLower(strings.TrimSpace(os.Getenv(allowScenarioDataTableProperty))) == "true"
 	specConverter := converterFn(func(token *Token, state *int) bool {
 		return token.Kind == SpecKind
 	}, func(token *Token, spec *Specification, state *int) ParseResult {
@@ -320,7 +383,7 @@ func (specParser *SpecParser) initializeConverters() []func(*Token, *int, *Speci
 			return ParseResult{Ok: false, ParseError: &ParseError{token.LineNo, "Parse error: Multiple spec headings found in same file", token.LineText}}
 		}
 
-		spec.addHeading(&Heading{LineNo: token.LineNo, Value: token.Value})
+		spec.addHeading(&Heading{LineNo: token.LineNo, Value: token.Value, Span: token.Span, LineText: token.LineText})
 		addStates(state, specScope)
 		return ParseResult{Ok: true}
 	})
@@ -337,7 +400,7 @@ func (specParser *SpecParser) initializeConverters() []func(*Token, *int, *Speci
 			}
 		}
 		scenario := &Scenario{}
-		scenario.addHeading(&Heading{Value: token.Value, LineNo: token.LineNo})
+		scenario.addHeading(&Heading{Value: token.Value, LineNo: token.LineNo, Span: token.Span, LineText: token.LineText})
 		spec.addScenario(scenario)
 
 		retainStates(state, specScope)
@@ -349,7 +412,7 @@ func (specParser *SpecParser) initializeConverters() []func(*Token, *int, *Speci
 		return token.Kind == StepKind && isInState(*state, scenarioScope)
 	}, func(token *Token, spec *Specification, state *int) ParseResult {
 		latestScenario := spec.latestScenario()
-		stepToAdd, parseDetails := spec.createStep(token)
+		stepToAdd, parseDetails := spec.createStepUsingScenarioTable(token, latestScenario)
 		if parseDetails != nil && parseDetails.Error != nil {
 			return ParseResult{ParseError: parseDetails.Error, Ok: false, Warnings: parseDetails.Warnings}
 		}
@@ -422,7 +485,17 @@ func (specParser *SpecParser) initializeConverters() []func(*Token, *int, *Speci
 		return token.Kind == DataTableKind
 	}, func(token *Token, spec *Specification, state *int) ParseResult {
 		resolvedArg, _ := newSpecialTypeResolver().resolve(token.Value)
-		if isInState(*state, specScope) && !spec.DataTable.IsInitialized() {
+		if isInState(*state, scenarioScope) && scenarioDataTableAllowed && spec.latestScenario().acceptsDataTable() {
+			externalTable := &DataTable{}
+			externalTable.Table = resolvedArg.Table
+			externalTable.LineNo = token.LineNo
+			externalTable.Value = token.Value
+			externalTable.IsExternal = true
+			spec.latestScenario().addExternalDataTable(externalTable)
+			retainStates(state, specScope, scenarioScope)
+			addStates(state, keywordScope)
+			return ParseResult{Ok: true}
+		} else if isInState(*state, specScope) && !spec.DataTable.IsInitialized() {
 			externalTable := &DataTable{}
 			externalTable.Table = resolvedArg.Table
 			externalTable.LineNo = token.LineNo
@@ -456,11 +529,18 @@ func (specParser *SpecParser) initializeConverters() []func(*Token, *int, *Speci
 		} else if isInState(*state, tearDownScope) {
 			latestTeardown := spec.latestTeardown()
 			addInlineTableHeader(latestTeardown, token)
+		} else if isInState(*state, scenarioScope) && scenarioDataTableAllowed && spec.latestScenario().acceptsDataTable() {
+			dataTable := &Table{}
+			dataTable.LineNo = token.LineNo
+			dataTable.AddHeaders(token.Args)
+			dataTable.HeaderSpans = token.ArgSpans
+			spec.latestScenario().addDataTable(dataTable)
 		} else if !isInState(*state, scenarioScope) {
 			if !spec.DataTable.Table.IsInitialized() {
 				dataTable := &Table{}
 				dataTable.LineNo = token.LineNo
 				dataTable.AddHeaders(token.Args)
+				dataTable.HeaderSpans = token.ArgSpans
 				spec.addDataTable(dataTable)
 			} else {
 				value := "Multiple data table present, ignoring table"
@@ -494,26 +574,86 @@ func (specParser *SpecParser) initializeConverters() []func(*Token, *int, *Speci
 		} else if isInState(*state, stepScope) {
 			latestScenario := spec.latestScenario()
 			latestStep := latestScenario.latestStep()
-			result = addInlineTableRow(latestStep, token, new(ArgLookup).fromDataTable(&spec.DataTable.Table))
+			result = addInlineTableRow(latestStep, token, spec.dataTableLookupFor(latestScenario))
 		} else if isInState(*state, contextScope) {
 			latestContext := spec.latestContext()
 			result = addInlineTableRow(latestContext, token, new(ArgLookup).fromDataTable(&spec.DataTable.Table))
 		} else if isInState(*state, tearDownScope) {
 			latestTeardown := spec.latestTeardown()
 			result = addInlineTableRow(latestTeardown, token, new(ArgLookup).fromDataTable(&spec.DataTable.Table))
+		} else if isInState(*state, scenarioScope) {
+			spec.latestScenario().DataTable.Table.addRowValuesAt(token.Args, token.ArgSpans)
+			result = ParseResult{Ok: true}
 		} else {
 			//todo validate datatable rows also
-			spec.DataTable.Table.AddRowValues(token.Args)
+			spec.DataTable.Table.addRowValuesAt(token.Args, token.ArgSpans)
 			result = ParseResult{Ok: true}
 		}
 		retainStates(state, specScope, scenarioScope, stepScope, contextScope, tearDownScope, tableScope)
 		return result
 	})
 
+	textBlockConverter := converterFn(func(token *Token, state *int) bool {
+		return token.Kind == TextBlockKind
+	}, func(token *Token, spec *Specification, state *int) ParseResult {
+		var step *Step
+		if isInState(*state, stepScope) {
+			step = spec.latestScenario().latestStep()
+		} else if isInState(*state, contextScope) {
+			step = spec.latestContext()
+		} else if isInState(*state, tearDownScope) && !isInState(*state, commentScope) && len(spec.TearDownSteps) > 0 {
+			step = spec.latestTeardown()
+		}
+		retainStates(state, specScope, scenarioScope, tearDownScope)
+		if step == nil || step.HasInlineTable || step.HasTextBlock {
+			value := "Text block not associated with a step, ignoring text block"
+			if isInState(*state, scenarioScope) {
+				spec.latestScenario().addComment(&Comment{token.LineText, token.LineNo})
+			} else {
+				spec.addComment(&Comment{token.LineText, token.LineNo})
+			}
+			return ParseResult{Ok: false, Warnings: []*Warning{&Warning{value, token.LineNo}}}
+		}
+		addTextBlock(step, token)
+		return ParseResult{Ok: true}
+	})
+
+	includeConverter := converterFn(func(token *Token, state *int) bool {
+		return token.Kind == IncludeKind
+	}, func(token *Token, spec *Specification, state *int) ParseResult {
+		if !isInState(*state, specScope) || isInState(*state, scenarioScope) || isInState(*state, tearDownScope) {
+			value := "Include should be placed before the first scenario, ignoring include"
+			if isInState(*state, scenarioScope) {
+				spec.latestScenario().addComment(&Comment{token.LineText, token.LineNo})
+			} else {
+				spec.addComment(&Comment{token.LineText, token.LineNo})
+			}
+			return ParseResult{Ok: false, Warnings: []*Warning{&Warning{value, token.LineNo}}}
+		}
+		include, result := spec.createInclude(token)
+		if result.ParseError != nil {
+			return *result
+		}
+		spec.addInclude(include)
+		retainStates(state, specScope)
+		return *result
+	})
+
+	frontMatterConverter := converterFn(func(token *Token, state *int) bool {
+		return token.Kind == FrontMatterKind
+	}, func(token *Token, spec *Specification, state *int) ParseResult {
+		frontMatter, result := createFrontMatter(token)
+		if result.ParseError != nil {
+			return *result
+		}
+		spec.FrontMatter = frontMatter
+		return *result
+	})
+
 	tagConverter := converterFn(func(token *Token, state *int) bool {
 		return (token.Kind == TagKind)
 	}, func(token *Token, spec *Specification, state *int) ParseResult {
-		tags := &Tags{Values: token.Args}
+		tags := &Tags{Values: token.Args, Spans: token.ArgSpans}
 		if isInState(*state, scenarioScope) {
 			spec.latestScenario().addTags(tags)
 		} else {
@@ -523,7 +663,7 @@ func (specParser *SpecParser) initializeConverters() []func(*Token, *int, *Speci
 	})
 
 	converter := []func(*Token, *int, *Specification) ParseResult{
-		specConverter, scenarioConverter, stepConverter, contextConverter, commentConverter, tableHeaderConverter, tableRowConverter, tagConverter, keywordConverter, tearDownConverter, tearDownStepConverter,
+		specConverter, scenarioConverter, stepConverter, contextConverter, commentConverter, tableHeaderConverter, tableRowConverter, tagConverter, keywordConverter, tearDownConverter, tearDownStepConverter, includeConverter, textBlockConverter, frontMatterConverter,
 	}
 
 	return converter
@@ -539,12 +679,32 @@ func (spec *Specification) createStep(stepToken *Token) (*Step, *ParseDetailResu
 	return stepToAdd, parseDetails
 }
 
+func (spec *Specification) createStepUsingScenarioTable(stepToken *Token, scenario *Scenario) (*Step, *ParseDetailResult) {
+	stepToAdd, parseDetails := spec.CreateStepUsingLookup(stepToken, spec.dataTableLookupFor(scenario))
+	if parseDetails != nil && parseDetails.Error != nil {
+		return nil, parseDetails
+	}
+	return stepToAdd, parseDetails
+}
+
+// dataTableLookupFor returns the lookup of dynamic params available to the steps of a scenario,
+// the columns of its own data table along with the columns of the spec data table
+func (spec *Specification) dataTableLookupFor(scenario *Scenario) *ArgLookup {
+	lookup := new(ArgLookup).fromDataTable(&spec.DataTable.Table)
+	if scenario.DataTable.Table.IsInitialized() {
+		for _, header := range scenario.DataTable.Table.Headers {
+			lookup.addArgName(header)
+		}
+	}
+	return lookup
+}
+
 func (spec *Specification) CreateStepUsingLookup(stepToken *Token, lookup *ArgLookup) (*Step, *ParseDetailResult) {
 	stepValue, argsType := extractStepValueAndParameterTypes(stepToken.Value)
 	if argsType != nil && len(argsType) != len(stepToken.Args) {
 		return nil, &ParseDetailResult{Error: &ParseError{stepToken.LineNo, "Step text should not have '{static}' or '{dynamic}' or '{special}'", stepToken.LineText}, Warnings: nil}
 	}
-	step := &Step{LineNo: stepToken.LineNo, Value: stepValue, LineText: strings.TrimSpace(stepToken.LineText)}
+	step := &Step{LineNo: stepToken.LineNo, Value: stepValue, LineText: strings.TrimSpace(stepToken.LineText), Span: stepToken.Span}
 	arguments := make([]*StepArg, 0)
 	var warnings []*Warning
 	for i, argType := range argsType {
@@ -552,6 +712,7 @@ func (spec *Specification) CreateStepUsingLookup(stepToken *Token, lookup *ArgLo
 		if parseDetails != nil && parseDetails.Error != nil {
 			return nil, parseDetails
 		}
+		argument.Span = spanAt(stepToken.ArgSpans, i)
 		arguments = append(arguments, argument)
 		if parseDetails != nil && parseDetails.Warnings != nil {
 			for _, warn := range parseDetails.Warnings {
@@ -586,8 +747,13 @@ func (specification *Specification) processConceptStep(step *Step, conceptDictio
 func (specification *Specification) createConceptStep(concept *Step, originalStep *Step) {
 	stepCopy := concept.getCopy()
 	originalArgs := originalStep.Args
+	originalSpan := originalStep.Span
+	originalValue := originalStep.Value
 	originalStep.copyFrom(stepCopy)
 	originalStep.Args = originalArgs
+	originalStep.Span = originalSpan
+	// a step omitting the parameters with default values keeps its own value
+	originalStep.Value = originalValue
 
 	// set parent of all concept steps to be the current concept (referred as originalStep here)
 	// this is used to fetch from parent's lookup when nested
@@ -637,6 +803,10 @@ func (specification *Specification) addExternalDataTable(externalTable *DataTabl
 }
 
 func (specification *Specification) addTags(tags *Tags) {
+	if specification.Tags != nil && isLastItem(specification.Items, specification.Tags) {
+		specification.Tags.add(tags)
+		return
+	}
 	specification.Tags = tags
 	specification.addItem(tags)
 }
@@ -664,6 +834,12 @@ func (specParser *SpecParser) validateSpec(specification *Specification) *ParseE
 	if dataTable.IsInitialized() && dataTable.GetRowCount() == 0 {
 		return &ParseError{LineNo: dataTable.LineNo, Message: "Data table should have at least 1 data row"}
 	}
+	for _, scenario := range specification.Scenarios {
+		scenarioTable := scenario.DataTable.Table
+		if scenarioTable.IsInitialized() && scenarioTable.GetRowCount() == 0 {
+			return &ParseError{LineNo: scenarioTable.LineNo, Message: "Data table should have at least 1 data row"}
+		}
+	}
 	return nil
 }
 
@@ -695,15 +871,17 @@ func (step *Step) addArgs(args ...*StepArg) {
 	step.PopulateFragments()
 }
 
-func (step *Step) addInlineTableHeaders(headers []string) {
-	tableArg := &StepArg{ArgType: TableArg}
+func (step *Step) addInlineTableHeaders(headers []string, span Span, headerSpans []Span) {
+	tableArg := &StepArg{ArgType: TableArg, Span: span}
 	tableArg.Table.AddHeaders(headers)
+	tableArg.Table.HeaderSpans = headerSpans
 	step.addArgs(tableArg)
 }
 
-func (step *Step) addInlineTableRow(row []TableCell) {
+func (step *Step) addInlineTableRow(row []TableCell, span Span) {
 	lastArg := step.Args[len(step.Args)-1]
 	lastArg.Table.addRows(row)
+	lastArg.Span.EndLine, lastArg.Span.EndColumn = span.EndLine, span.EndColumn
 	step.PopulateFragments()
 }
 
@@ -840,32 +1018,47 @@ func validateDynamicArg(argValue string, token *Token, lookup *ArgLookup) (*Step
 func addInlineTableHeader(step *Step, token *Token) {
 	step.Value = fmt.Sprintf("%s %s", step.Value, ParameterPlaceholder)
 	step.HasInlineTable = true
-	step.addInlineTableHeaders(token.Args)
+	step.addInlineTableHeaders(token.Args, token.Span, token.ArgSpans)
+
+}
 
+func addTextBlock(step *Step, token *Token) {
+	step.Value = fmt.Sprintf("%s %s", step.Value, ParameterPlaceholder)
+	step.HasTextBlock = true
+	step.addArgs(&StepArg{ArgType: TextBlock, Value: token.Value, Name: token.Args[0], Span: token.Span})
 }
 
 func addInlineTableRow(step *Step, token *Token, argLookup *ArgLookup) ParseResult {
 	dynamicArgMatcher := regexp.MustCompile("^<(.*)>$")
 	tableValues := make([]TableCell, 0)
 	warnings := make([]*Warning, 0)
-	for _, tableValue := range token.Args {
+	for i, tableValue := range token.Args {
+		span := spanAt(token.ArgSpans, i)
 		if dynamicArgMatcher.MatchString(tableValue) {
 			match := dynamicArgMatcher.FindAllStringSubmatch(tableValue, -1)
 			param := match[0][1]
 			if !argLookup.containsArg(param) {
-				tableValues = append(tableValues, TableCell{Value: tableValue, CellType: Static})
+				tableValues = append(tableValues, TableCell{Value: tableValue, CellType: Static, Span: span})
 				warnings = append(warnings, &Warning{LineNo: token.LineNo, Message: fmt.Sprintf("Dynamic param <%s> could not be resolved, Treating it as static param", param)})
 			} else {
-				tableValues = append(tableValues, TableCell{Value: param, CellType: Dynamic})
+				tableValues = append(tableValues, TableCell{Value: param, CellType: Dynamic, Span: span})
 			}
 		} else {
-			tableValues = append(tableValues, TableCell{Value: tableValue, CellType: Static})
+			tableValues = append(tableValues, TableCell{Value: tableValue, CellType: Static, Span: span})
 		}
 	}
-	step.addInlineTableRow(tableValues)
+	step.addInlineTableRow(tableValues, token.Span)
 	return ParseResult{Ok: true, Warnings: warnings}
 }
 
+// spanAt returns the span of the i-th token arg, tokens which are not created by the tokenizer have none.
+func spanAt(spans []Span, i int) Span {
+	if i < len(spans) {
+		return spans[i]
+	}
+	return Span{}
+}
+
 //concept header will have dynamic param and should not be resolved through lookup, so passing nil lookup
 func isConceptHeader(lookup *ArgLookup) bool {
 	return lookup == nil
@@ -914,15 +1107,19 @@ func (lookup *ArgLookup) getCopy() *ArgLookup {
 }
 
 func (lookup *ArgLookup) FromDataTableRow(datatable *Table, index int) *ArgLookup {
-	dataTableLookup := new(ArgLookup)
+	return new(ArgLookup).AddDataTableRow(datatable, index)
+}
+
+//adds the values of a data table row to the lookup, overriding the columns already present
+func (lookup *ArgLookup) AddDataTableRow(datatable *Table, index int) *ArgLookup {
 	if !datatable.IsInitialized() {
-		return dataTableLookup
+		return lookup
 	}
 	for _, header := range datatable.Headers {
-		dataTableLookup.addArgName(header)
-		dataTableLookup.addArgValue(header, &StepArg{Value: datatable.Get(header)[index].Value, ArgType: Static})
+		lookup.addArgName(header)
+		lookup.addArgValue(header, &StepArg{Value: datatable.Get(header)[index].Value, ArgType: Static})
 	}
-	return dataTableLookup
+	return lookup
 }
 
 //create an empty lookup with only args to resolve dynamic params for steps
@@ -956,10 +1153,29 @@ func (scenario *Scenario) addStep(step *Step) {
 }
 
 func (scenario *Scenario) addTags(tags *Tags) {
+	if scenario.Tags != nil && isLastItem(scenario.Items, scenario.Tags) {
+		scenario.Tags.add(tags)
+		return
+	}
 	scenario.Tags = tags
 	scenario.addItem(tags)
 }
 
+func (scenario *Scenario) addDataTable(table *Table) {
+	scenario.DataTable.Table = *table
+	scenario.addItem(&scenario.DataTable)
+}
+
+func (scenario *Scenario) addExternalDataTable(externalTable *DataTable) {
+	scenario.DataTable = *externalTable
+	scenario.addItem(&scenario.DataTable)
+}
+
+// a scenario can declare its own data table only before its first step
+func (scenario *Scenario) acceptsDataTable() bool {
+	return len(scenario.Steps) == 0 && !scenario.DataTable.IsInitialized()
+}
+
 func (scenario *Scenario) addComment(comment *Comment) {
 	scenario.Comments = append(scenario.Comments, comment)
 	scenario.addItem(comment)
@@ -1061,8 +1277,10 @@ func (self *Step) copyFrom(another *Step) {
 	}
 
 	self.LineNo = another.LineNo
+	self.Span = another.Span
 	self.LineText = another.LineText
 	self.HasInlineTable = another.HasInlineTable
+	self.HasTextBlock = another.HasTextBlock
 	self.Value = another.Value
 	self.Lookup = another.Lookup
 	self.Parent = another.Parent
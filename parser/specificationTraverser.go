@@ -18,6 +18,7 @@
 package parser
 
 type SpecTraverser interface {
+	FrontMatter(*FrontMatter)
 	SpecHeading(*Heading)
 	SpecTags(*Tags)
 	DataTable(*Table)
@@ -28,17 +29,23 @@ type SpecTraverser interface {
 	ScenarioTags(*Tags)
 	Step(*Step)
 	TearDown(*TearDown)
+	Include(*Include)
 	Comment(*Comment)
 }
 
 type ScenarioTraverser interface {
 	ScenarioHeading(*Heading)
 	ScenarioTags(*Tags)
+	DataTable(*Table)
+	ExternalDataTable(*DataTable)
 	Step(*Step)
 	Comment(*Comment)
 }
 
 func (spec *Specification) Traverse(traverser SpecTraverser) {
+	if spec.FrontMatter != nil {
+		traverser.FrontMatter(spec.FrontMatter)
+	}
 	traverser.SpecHeading(spec.Heading)
 	for _, item := range spec.Items {
 		switch item.Kind() {
@@ -55,6 +62,8 @@ func (spec *Specification) Traverse(traverser SpecTraverser) {
 			traverser.SpecTags(item.(*Tags))
 		case TearDownKind:
 			traverser.TearDown(item.(*TearDown))
+		case IncludeKind:
+			traverser.Include(item.(*Include))
 		case DataTableKind:
 			if !item.(*DataTable).IsExternal {
 				traverser.DataTable(&item.(*DataTable).Table)
@@ -75,6 +84,12 @@ func (scenario *Scenario) Traverse(traverser ScenarioTraverser) {
 			traverser.Comment(item.(*Comment))
 		case TagKind:
 			traverser.ScenarioTags(item.(*Tags))
+		case DataTableKind:
+			if !item.(*DataTable).IsExternal {
+				traverser.DataTable(&item.(*DataTable).Table)
+			} else {
+				traverser.ExternalDataTable(item.(*DataTable))
+			}
 		}
 	}
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/golang/protobuf/proto"
 	. "gopkg.in/check.v1"
+	"os"
 )
 
 func (s *MySuite) TestThrowsErrorForMultipleSpecHeading(c *C) {
@@ -451,6 +452,24 @@ func (s *MySuite) TestAddSpecTagsAndScenarioTags(c *C) {
 	c.Assert(tags.Values[1], Equals, "tag4")
 }
 
+func (s *MySuite) TestTagsOnConsecutiveLinesAreAddedTogether(c *C) {
+	tokens := []*Token{
+		&Token{Kind: SpecKind, Value: "Spec Heading", LineNo: 1},
+		&Token{Kind: TagKind, Args: []string{"tag1", "tag2"}, LineNo: 2},
+		&Token{Kind: TagKind, Args: []string{"tag3"}, LineNo: 3},
+		&Token{Kind: ScenarioKind, Value: "Scenario Heading", LineNo: 4},
+		&Token{Kind: TagKind, Args: []string{"tag4"}, LineNo: 5},
+		&Token{Kind: TagKind, Args: []string{"tag5"}, LineNo: 6},
+	}
+
+	spec, result := new(SpecParser).CreateSpecification(tokens, new(ConceptDictionary))
+
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(spec.Tags.Values, DeepEquals, []string{"tag1", "tag2", "tag3"})
+	c.Assert(spec.Scenarios[0].Tags.Values, DeepEquals, []string{"tag4", "tag5"})
+	c.Assert(len(spec.Scenarios[0].Items), Equals, 1)
+}
+
 func (s *MySuite) TestErrorOnAddingDynamicParamterWithoutADataTable(c *C) {
 	tokens := []*Token{
 		&Token{Kind: SpecKind, Value: "Spec Heading", LineNo: 1},
@@ -1024,3 +1043,77 @@ func (s *MySuite) TestTearDownSteps(c *C) {
 	c.Assert(spec.TearDownSteps[1].Value, Equals, "Example step2")
 	c.Assert(spec.TearDownSteps[1].LineNo, Equals, 10)
 }
+
+func (s *MySuite) TestScenarioDataTable(c *C) {
+	os.Setenv(allowScenarioDataTableProperty, "true")
+	defer os.Unsetenv(allowScenarioDataTableProperty)
+
+	tokens := []*Token{
+		&Token{Kind: SpecKind, Value: "Spec Heading", LineNo: 1},
+		&Token{Kind: ScenarioKind, Value: "First scenario", LineNo: 2},
+		&Token{Kind: TableHeader, Args: []string{"id", "name"}, LineNo: 3},
+		&Token{Kind: TableRow, Args: []string{"1", "foo"}, LineNo: 4},
+		&Token{Kind: TableRow, Args: []string{"2", "bar"}, LineNo: 5},
+		&Token{Kind: StepKind, Value: "Step with {dynamic}", Args: []string{"name"}, LineNo: 6},
+		&Token{Kind: ScenarioKind, Value: "Second scenario", LineNo: 7},
+		&Token{Kind: StepKind, Value: "Another step", LineNo: 8},
+	}
+
+	spec, result := new(SpecParser).CreateSpecification(tokens, new(ConceptDictionary))
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(spec.DataTable.IsInitialized(), Equals, false)
+
+	scenario := spec.Scenarios[0]
+	c.Assert(scenario.DataTable.Table.Headers, DeepEquals, []string{"id", "name"})
+	c.Assert(scenario.DataTable.Table.GetRowCount(), Equals, 2)
+	c.Assert(scenario.Items[0], Equals, &scenario.DataTable)
+	c.Assert(scenario.Steps[0].Args[0].ArgType, Equals, Dynamic)
+	c.Assert(spec.Scenarios[1].DataTable.IsInitialized(), Equals, false)
+}
+
+func (s *MySuite) TestScenarioDataTableWithUnknownDynamicParam(c *C) {
+	os.Setenv(allowScenarioDataTableProperty, "true")
+	defer os.Unsetenv(allowScenarioDataTableProperty)
+
+	tokens := []*Token{
+		&Token{Kind: SpecKind, Value: "Spec Heading", LineNo: 1},
+		&Token{Kind: ScenarioKind, Value: "First scenario", LineNo: 2},
+		&Token{Kind: TableHeader, Args: []string{"id"}, LineNo: 3},
+		&Token{Kind: TableRow, Args: []string{"1"}, LineNo: 4},
+		&Token{Kind: StepKind, Value: "Step", LineNo: 5},
+		&Token{Kind: ScenarioKind, Value: "Second scenario", LineNo: 6},
+		&Token{Kind: StepKind, Value: "Step with {dynamic}", Args: []string{"id"}, LineNo: 7, LineText: "* Step with <id>"},
+	}
+
+	_, result := new(SpecParser).CreateSpecification(tokens, new(ConceptDictionary))
+	c.Assert(result.Ok, Equals, false)
+	c.Assert(result.ParseError.LineNo, Equals, 7)
+}
+
+func (s *MySuite) TestStepWithTextBlock(c *C) {
+	tokens := []*Token{
+		&Token{Kind: SpecKind, Value: "Spec Heading", LineNo: 1},
+		&Token{Kind: StepKind, Value: "context step", LineNo: 2},
+		&Token{Kind: TextBlockKind, Value: "context", Args: []string{""}, LineNo: 3},
+		&Token{Kind: ScenarioKind, Value: "Scenario Heading", LineNo: 6},
+		&Token{Kind: StepKind, Value: "post request", LineNo: 7},
+		&Token{Kind: TextBlockKind, Value: "{\"id\": 1}", Args: []string{"json"}, LineNo: 8},
+		&Token{Kind: TextBlockKind, Value: "ignored", Args: []string{""}, LineNo: 11, LineText: "```\nignored\n```"},
+	}
+
+	spec, result := new(SpecParser).CreateSpecification(tokens, new(ConceptDictionary))
+
+	c.Assert(result.Ok, Equals, true)
+	c.Assert(spec.Contexts[0].Value, Equals, "context step {}")
+	c.Assert(spec.Contexts[0].Args[0].Value, Equals, "context")
+
+	step := spec.Scenarios[0].Steps[0]
+	c.Assert(step.Value, Equals, "post request {}")
+	c.Assert(step.HasTextBlock, Equals, true)
+	c.Assert(step.Args[0].ArgType, Equals, TextBlock)
+	c.Assert(step.Args[0].Value, Equals, "{\"id\": 1}")
+	c.Assert(step.Args[0].Name, Equals, "json")
+
+	c.Assert(len(result.Warnings), Equals, 1)
+	c.Assert(result.Warnings[0].String(), Equals, "line no: 11, Text block not associated with a step, ignoring text block")
+}
@@ -23,6 +23,8 @@ import (
 	"fmt"
 	"github.com/getgauge/common"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 type SpecParser struct {
@@ -42,6 +44,8 @@ type Token struct {
 	LineText string
 	Args     []string
 	Value    string
+	Span     Span
+	ArgSpans []Span
 }
 
 type ParseError struct {
@@ -89,8 +93,13 @@ const (
 	TableKind
 	DataTableKind
 	TearDownKind
+	IncludeKind
+	TextBlockKind
+	FrontMatterKind
 )
 
+const textBlockFence = "```"
+
 func (parser *SpecParser) initialize() {
 	parser.processors = make(map[TokenKind]func(*SpecParser, *Token) (*ParseError, bool))
 	parser.processors[SpecKind] = processSpec
@@ -102,6 +111,9 @@ func (parser *SpecParser) initialize() {
 	parser.processors[TableRow] = processTable
 	parser.processors[DataTableKind] = processDataTable
 	parser.processors[TearDownKind] = processTearDown
+	parser.processors[IncludeKind] = processInclude
+	parser.processors[TextBlockKind] = processTextBlock
+	parser.processors[FrontMatterKind] = processFrontMatter
 }
 
 func (parser *SpecParser) Parse(specText string, conceptDictionary *ConceptDictionary) (*Specification, *ParseResult) {
@@ -121,18 +133,26 @@ func (parser *SpecParser) GenerateTokens(specText string) ([]*Token, *ParseError
 		var newToken *Token
 		if len(trimmedLine) == 0 {
 			newToken = &Token{Kind: CommentKind, LineNo: parser.lineNo, LineText: line, Value: "\n"}
+		} else if parser.isFrontMatterStart(trimmedLine) {
+			frontMatterToken, parseError := parser.readFrontMatter(line)
+			if parseError != nil {
+				return nil, parseError
+			}
+			newToken = frontMatterToken
 		} else if parser.isScenarioHeading(trimmedLine) {
 			newToken = &Token{Kind: ScenarioKind, LineNo: parser.lineNo, LineText: line, Value: strings.TrimSpace(trimmedLine[2:])}
 		} else if parser.isSpecHeading(trimmedLine) {
 			newToken = &Token{Kind: SpecKind, LineNo: parser.lineNo, LineText: line, Value: strings.TrimSpace(trimmedLine[1:])}
-		} else if parser.isSpecUnderline(trimmedLine) && (isInState(parser.currentState, commentScope)) {
-			newToken = parser.tokens[len(parser.tokens)-1]
-			newToken.Kind = SpecKind
-			parser.tokens = append(parser.tokens[:len(parser.tokens)-1])
-		} else if parser.isScenarioUnderline(trimmedLine) && (isInState(parser.currentState, commentScope)) {
-			newToken = parser.tokens[len(parser.tokens)-1]
-			newToken.Kind = ScenarioKind
-			parser.tokens = append(parser.tokens[:len(parser.tokens)-1])
+		} else if parser.isSpecUnderline(trimmedLine) && parser.isUnderliningText() {
+			newToken = parser.underlinedHeading(SpecKind, line)
+		} else if parser.isScenarioUnderline(trimmedLine) && parser.isUnderliningText() {
+			newToken = parser.underlinedHeading(ScenarioKind, line)
+		} else if parser.isTextBlockFence(trimmedLine) && parser.followsStep() {
+			textBlockToken, parseError := parser.readTextBlock(line)
+			if parseError != nil {
+				return nil, parseError
+			}
+			newToken = textBlockToken
 		} else if parser.isStep(trimmedLine) {
 			newToken = &Token{Kind: StepKind, LineNo: parser.lineNo, LineText: strings.TrimSpace(trimmedLine[1:]), Value: strings.TrimSpace(trimmedLine[1:])}
 		} else if found, startIndex := parser.checkTag(trimmedLine); found {
@@ -142,11 +162,16 @@ func (parser *SpecParser) GenerateTokens(specText string) ([]*Token, *ParseError
 			newToken = &Token{Kind: kind, LineNo: parser.lineNo, LineText: line, Value: strings.TrimSpace(trimmedLine)}
 		} else if value, found := parser.isDataTable(trimmedLine); found {
 			newToken = &Token{Kind: DataTableKind, LineNo: parser.lineNo, LineText: line, Value: value}
+		} else if value, found := parser.isInclude(trimmedLine); found {
+			newToken = &Token{Kind: IncludeKind, LineNo: parser.lineNo, LineText: line, Value: value}
 		} else if parser.isTearDown(trimmedLine) {
 			newToken = &Token{Kind: TearDownKind, LineNo: parser.lineNo, LineText: line, Value: trimmedLine}
 		} else {
 			newToken = &Token{Kind: CommentKind, LineNo: parser.lineNo, LineText: line, Value: common.TrimTrailingSpace(line)}
 		}
+		if newToken.Span.StartLine == 0 {
+			newToken.Span = valueSpan(parser.lineNo, line, newToken.Value)
+		}
 		error := parser.accept(newToken)
 		if error != nil {
 			return nil, error
@@ -156,6 +181,26 @@ func (parser *SpecParser) GenerateTokens(specText string) ([]*Token, *ParseError
 
 }
 
+// valueSpan locates the token value in the line it was read from. Values which are
+// not taken verbatim from the line span the trimmed line instead.
+func valueSpan(lineNo int, line string, value string) Span {
+	trimmedLine := strings.TrimRightFunc(line, unicode.IsSpace)
+	value = strings.TrimLeftFunc(value, unicode.IsSpace)
+	end := len(trimmedLine)
+	start := end - len(value)
+	if value == "" || !strings.HasSuffix(trimmedLine, value) {
+		start = len(trimmedLine) - len(strings.TrimLeftFunc(trimmedLine, unicode.IsSpace))
+	}
+	startColumn := utf8.RuneCountInString(line[:start]) + 1
+	return Span{StartLine: lineNo, StartColumn: startColumn, EndLine: lineNo, EndColumn: startColumn + utf8.RuneCountInString(line[start:end])}
+}
+
+// subSpan returns the span of token.Value[start:end].
+func (token *Token) subSpan(start int, end int) Span {
+	startColumn := token.Span.StartColumn + utf8.RuneCountInString(token.Value[:start])
+	return Span{StartLine: token.LineNo, StartColumn: startColumn, EndLine: token.LineNo, EndColumn: startColumn + utf8.RuneCountInString(token.Value[start:end])}
+}
+
 func (parser *SpecParser) tokenKindBasedOnCurrentState(state int, matchingToken TokenKind, alternateToken TokenKind) TokenKind {
 	if isInState(parser.currentState, state) {
 		return matchingToken
@@ -195,12 +240,40 @@ func (parser *SpecParser) isScenarioHeading(text string) bool {
 
 func (parser *SpecParser) isStep(text string) bool {
 	if len(text) > 1 {
-		return text[0] == '*' && text[1] != '*'
+		return text[0] == '*' && text[1] != '*' && !isEmphasis(text)
 	} else {
 		return text[0] == '*'
 	}
 }
 
+// isEmphasis tells whether the text starts with markdown emphasis like *note*, rather than being a step.
+func isEmphasis(text string) bool {
+	if unicode.IsSpace(rune(text[1])) {
+		return false
+	}
+	closing := strings.Index(text[1:], "*") + 1
+	if closing < 2 || unicode.IsSpace(rune(text[closing-1])) {
+		return false
+	}
+	next, _ := utf8.DecodeRuneInString(text[closing+1:])
+	return closing == len(text)-1 || !(unicode.IsLetter(next) || unicode.IsDigit(next))
+}
+
+// isUnderliningText tells whether an underline makes a heading of the previous line. An underline following
+// a blank line is a markdown thematic break instead.
+func (parser *SpecParser) isUnderliningText() bool {
+	return isInState(parser.currentState, commentScope) && parser.tokens[len(parser.tokens)-1].Value != "\n"
+}
+
+// underlinedHeading turns the previous line into a heading, the line text of the heading holds both lines.
+func (parser *SpecParser) underlinedHeading(kind TokenKind, underline string) *Token {
+	heading := parser.tokens[len(parser.tokens)-1]
+	heading.Kind = kind
+	heading.LineText = heading.LineText + "\n" + underline
+	parser.tokens = parser.tokens[:len(parser.tokens)-1]
+	return heading
+}
+
 func (parser *SpecParser) isScenarioUnderline(text string) bool {
 	return isUnderline(text, rune('-'))
 }
@@ -218,14 +291,45 @@ func (parser *SpecParser) isSpecUnderline(text string) bool {
 
 }
 
+func (parser *SpecParser) isTextBlockFence(text string) bool {
+	return strings.HasPrefix(text, textBlockFence)
+}
+
+// followsStep tells if the line being read comes right after a step. Only such a fence opens a text block,
+// fences anywhere else are comments.
+func (parser *SpecParser) followsStep() bool {
+	return len(parser.tokens) > 0 && parser.tokens[len(parser.tokens)-1].Kind == StepKind
+}
+
+// readTextBlock reads the lines up to the closing fence. The opening fence can carry a language hint
+// and the indentation of the fence is removed from the lines of the block.
+func (parser *SpecParser) readTextBlock(fenceLine string) (*Token, *ParseError) {
+	startLineNo := parser.lineNo
+	indent := fenceLine[:len(fenceLine)-len(strings.TrimLeftFunc(fenceLine, unicode.IsSpace))]
+	language := strings.TrimSpace(strings.TrimSpace(fenceLine)[len(textBlockFence):])
+	blockLines := []string{fenceLine}
+	textLines := make([]string, 0)
+	for line, hasLine := parser.nextLine(); hasLine; line, hasLine = parser.nextLine() {
+		blockLines = append(blockLines, line)
+		if strings.TrimSpace(line) == textBlockFence {
+			token := &Token{Kind: TextBlockKind, LineNo: startLineNo, LineText: strings.Join(blockLines, "\n"), Value: strings.Join(textLines, "\n"), Args: []string{language}}
+			endColumn := utf8.RuneCountInString(strings.TrimRightFunc(line, unicode.IsSpace)) + 1
+			token.Span = Span{StartLine: startLineNo, StartColumn: utf8.RuneCountInString(indent) + 1, EndLine: parser.lineNo, EndColumn: endColumn}
+			return token, nil
+		}
+		textLines = append(textLines, strings.TrimPrefix(line, indent))
+	}
+	return nil, &ParseError{LineNo: startLineNo, LineText: fenceLine, Message: "Text block is not closed"}
+}
+
 func (parser *SpecParser) isDataTable(text string) (string, bool) {
 	lowerCased := strings.ToLower
 	tableColon := "table:"
 	tableSpaceColon := "table :"
 	if strings.HasPrefix(lowerCased(text), tableColon) {
-		return tableColon + " " + strings.TrimSpace(strings.Replace(lowerCased(text), tableColon, "", 1)), true
+		return tableColon + " " + strings.TrimSpace(text[len(tableColon):]), true
 	} else if strings.HasPrefix(lowerCased(text), tableSpaceColon) {
-		return tableColon + " " + strings.TrimSpace(strings.Replace(lowerCased(text), tableSpaceColon, "", 1)), true
+		return tableColon + " " + strings.TrimSpace(text[len(tableSpaceColon):]), true
 	}
 	return "", false
 }
@@ -267,6 +371,11 @@ func processDataTable(parser *SpecParser, token *Token) (*ParseError, bool) {
 	return nil, false
 }
 
+func processTextBlock(parser *SpecParser, token *Token) (*ParseError, bool) {
+	parser.clearState()
+	return nil, false
+}
+
 func processScenario(parser *SpecParser, token *Token) (*ParseError, bool) {
 	if len(token.Value) < 1 {
 		return &ParseError{LineNo: parser.lineNo, LineText: token.Value, Message: "Scenario heading should have at least one character"}, true
@@ -285,9 +394,13 @@ func processTag(parser *SpecParser, token *Token) (*ParseError, bool) {
 	parser.clearState()
 	tokens := splitAndTrimTags(token.Value)
 
+	offset := 0
 	for _, tagValue := range tokens {
 		if len(tagValue) > 0 {
+			start := offset + strings.Index(token.Value[offset:], tagValue)
+			offset = start + len(tagValue)
 			token.Args = append(token.Args, tagValue)
+			token.ArgSpans = append(token.ArgSpans, token.subSpan(start, offset))
 		}
 	}
 	return nil, false
@@ -305,10 +418,19 @@ func processTable(parser *SpecParser, token *Token) (*ParseError, bool) {
 
 	var buffer bytes.Buffer
 	shouldEscape := false
+	cellStart, cellEnd := -1, -1
 	for i, element := range token.Value {
 		if i == 0 {
 			continue
 		}
+		if element != '|' || shouldEscape {
+			if cellStart == -1 && !unicode.IsSpace(element) {
+				cellStart = i
+			}
+			if !unicode.IsSpace(element) || shouldEscape {
+				cellEnd = i + utf8.RuneLen(element)
+			}
+		}
 		if shouldEscape {
 			buffer.WriteRune(element)
 			shouldEscape = false
@@ -329,7 +451,12 @@ func processTable(parser *SpecParser, token *Token) (*ParseError, bool) {
 					return &ParseError{LineNo: parser.lineNo, LineText: token.Value, Message: "Table header cannot have repeated column values"}, true
 				}
 			}
+			if cellStart == -1 {
+				cellStart, cellEnd = i, i
+			}
 			token.Args = append(token.Args, trimmedValue)
+			token.ArgSpans = append(token.ArgSpans, token.subSpan(cellStart, cellEnd))
+			cellStart, cellEnd = -1, -1
 			buffer.Reset()
 		} else {
 			buffer.WriteRune(element)
@@ -604,3 +604,91 @@ func (s *MySuite) TestToSplitTagNames(c *C) {
 	c.Assert(allTags[1], Equals, "tag2")
 	c.Assert(allTags[2], Equals, "tag3")
 }
+
+func (s *MySuite) TestStepTokenHasSpansOfStepAndArgs(c *C) {
+	parser := new(SpecParser)
+	tokens, err := parser.GenerateTokens("  * say \"héllo\" to <name> ")
+
+	c.Assert(err, IsNil)
+	c.Assert(tokens[0].Span, Equals, Span{StartLine: 1, StartColumn: 5, EndLine: 1, EndColumn: 26})
+	c.Assert(len(tokens[0].ArgSpans), Equals, 2)
+	c.Assert(tokens[0].ArgSpans[0], Equals, Span{StartLine: 1, StartColumn: 9, EndLine: 1, EndColumn: 16})
+	c.Assert(tokens[0].ArgSpans[1], Equals, Span{StartLine: 1, StartColumn: 20, EndLine: 1, EndColumn: 26})
+}
+
+func (s *MySuite) TestTagAndTableTokensHaveSpansOfEachValue(c *C) {
+	parser := new(SpecParser)
+	tokens, err := parser.GenerateTokens("# Spec\ntags: foo, bar baz\n|id |  name|\n|  |\\|x|")
+
+	c.Assert(err, IsNil)
+	c.Assert(tokens[0].Span, Equals, Span{StartLine: 1, StartColumn: 3, EndLine: 1, EndColumn: 7})
+	c.Assert(tokens[1].ArgSpans, DeepEquals, []Span{{2, 7, 2, 10}, {2, 12, 2, 19}})
+	c.Assert(tokens[2].ArgSpans, DeepEquals, []Span{{3, 2, 3, 4}, {3, 8, 3, 12}})
+	c.Assert(tokens[3].ArgSpans, DeepEquals, []Span{{4, 4, 4, 4}, {4, 5, 4, 8}})
+}
+
+func (s *MySuite) TestParsingTextBlock(c *C) {
+	parser := new(SpecParser)
+	specText := "# Spec\n## Scenario\n* post request\n  ```json\n  {\n    \"id\": 1\n  }\n  ```\n* next step"
+
+	tokens, err := parser.GenerateTokens(specText)
+
+	c.Assert(err, IsNil)
+	c.Assert(len(tokens), Equals, 5)
+	c.Assert(tokens[3].Kind, Equals, TextBlockKind)
+	c.Assert(tokens[3].Value, Equals, "{\n  \"id\": 1\n}")
+	c.Assert(tokens[3].Args, DeepEquals, []string{"json"})
+	c.Assert(tokens[3].LineNo, Equals, 4)
+	c.Assert(tokens[3].Span, Equals, Span{StartLine: 4, StartColumn: 3, EndLine: 8, EndColumn: 6})
+	c.Assert(tokens[4].Kind, Equals, StepKind)
+}
+
+func (s *MySuite) TestParsingUnclosedTextBlock(c *C) {
+	parser := new(SpecParser)
+	_, err := parser.GenerateTokens("# Spec\n## Scenario\n* post request\n```\nbody\n")
+
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "line no: 4, Text block is not closed")
+}
+
+func (s *MySuite) TestFenceNotFollowingAStepIsAComment(c *C) {
+	parser := new(SpecParser)
+	tokens, err := parser.GenerateTokens("# Spec\nSee ```this``` in the readme\n```\n## Scenario\n* step\n\n```\nnot a text block\n```\n")
+
+	c.Assert(err, IsNil)
+	c.Assert(tokens[1].Kind, Equals, CommentKind)
+	c.Assert(tokens[2].Kind, Equals, CommentKind)
+	c.Assert(tokens[2].Value, Equals, "```")
+	c.Assert(tokens[3].Kind, Equals, ScenarioKind)
+	for _, token := range tokens[4:] {
+		c.Assert(token.Kind, Not(Equals), TextBlockKind)
+	}
+}
+
+func (s *MySuite) TestHeadingTokensKeepTheirMarkdown(c *C) {
+	tokens, err := new(SpecParser).GenerateTokens("# Spec Heading\nSome Scenario\n-------\n* step\n")
+
+	c.Assert(err, IsNil)
+	c.Assert(tokens[0].LineText, Equals, "# Spec Heading")
+	c.Assert(tokens[1].Kind, Equals, ScenarioKind)
+	c.Assert(tokens[1].LineText, Equals, "Some Scenario\n-------")
+}
+
+func (s *MySuite) TestEmphasisIsNotParsedAsStep(c *C) {
+	tokens, err := new(SpecParser).GenerateTokens("# Spec Heading\n*Note:* steps below need a login\n* step\n")
+
+	c.Assert(err, IsNil)
+	c.Assert(len(tokens), Equals, 3)
+	c.Assert(tokens[1].Kind, Equals, CommentKind)
+	c.Assert(tokens[2].Kind, Equals, StepKind)
+}
+
+func (s *MySuite) TestUnderlineAfterEmptyLineIsNotHeading(c *C) {
+	tokens, err := new(SpecParser).GenerateTokens("# Spec Heading\nsome text\n\n---\n")
+
+	c.Assert(err, IsNil)
+	c.Assert(len(tokens), Equals, 4)
+	c.Assert(tokens[1].Kind, Equals, CommentKind)
+	c.Assert(tokens[3].Kind, Equals, CommentKind)
+	c.Assert(tokens[3].Value, Equals, "---")
+}
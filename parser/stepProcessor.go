@@ -75,11 +75,14 @@ func processStep(parser *SpecParser, token *Token) (*ParseError, bool) {
 		return &ParseError{LineNo: token.LineNo, LineText: token.LineText, Message: "Step should not be blank"}, true
 	}
 
-	stepValue, args, err := processStepText(token.Value)
+	stepValue, args, argRanges, err := scanStepText(token.Value)
 	if err != nil {
 		return &ParseError{LineNo: token.LineNo, LineText: token.LineText, Message: err.Error()}, true
 	}
 
+	for _, argRange := range argRanges {
+		token.ArgSpans = append(token.ArgSpans, token.subSpan(argRange[0], argRange[1]))
+	}
 	token.Value = stepValue
 	token.Args = args
 	parser.clearState()
@@ -87,10 +90,18 @@ func processStep(parser *SpecParser, token *Token) (*ParseError, bool) {
 }
 
 func processStepText(text string) (string, []string, error) {
+	stepValue, args, _, err := scanStepText(text)
+	return stepValue, args, err
+}
+
+// scanStepText also returns the byte range of every arg in text, delimiters included.
+func scanStepText(text string) (string, []string, [][2]int, error) {
 	reservedChars := map[rune]struct{}{'{': {}, '}': {}}
 	var stepValue, argText bytes.Buffer
 
 	var args []string
+	var argRanges [][2]int
+	argStart := 0
 
 	curBuffer := func(state int) *bytes.Buffer {
 		if isInAnyState(state, inQuotes, inDynamicParam) {
@@ -125,7 +136,14 @@ func processStepText(text string) (string, []string, error) {
 	}, inDynamicParam)
 
 	var inParamBoundary bool
-	for _, element := range text {
+	paramBoundary := func(i int) {
+		if currentState == inDefault {
+			argRanges = append(argRanges, [2]int{argStart, i + 1})
+		} else {
+			argStart = i
+		}
+	}
+	for i, element := range text {
 		if currentState == inEscape {
 			currentState = lastState
 			element = getEscapedRuneIfValid(element)
@@ -134,11 +152,13 @@ func processStepText(text string) (string, []string, error) {
 			currentState = inEscape
 			continue
 		} else if currentState, inParamBoundary = acceptSpecialDynamicParam(element, currentState); inParamBoundary {
+			paramBoundary(i)
 			continue
 		} else if currentState, inParamBoundary = acceptStaticParam(element, currentState); inParamBoundary {
+			paramBoundary(i)
 			continue
 		} else if _, isReservedChar := reservedChars[element]; currentState == inDefault && isReservedChar {
-			return "", nil, fmt.Errorf("'%c' is a reserved character and should be escaped", element)
+			return "", nil, nil, fmt.Errorf("'%c' is a reserved character and should be escaped", element)
 		}
 
 		curBuffer(currentState).WriteRune(element)
@@ -146,12 +166,12 @@ func processStepText(text string) (string, []string, error) {
 
 	// If it is a valid step, the state should be default when the control reaches here
 	if currentState == inQuotes {
-		return "", nil, fmt.Errorf("String not terminated")
+		return "", nil, nil, fmt.Errorf("String not terminated")
 	} else if isInState(currentState, inDynamicParam) {
-		return "", nil, fmt.Errorf("Dynamic parameter not terminated")
+		return "", nil, nil, fmt.Errorf("Dynamic parameter not terminated")
 	}
 
-	return strings.TrimSpace(stepValue.String()), args, nil
+	return strings.TrimSpace(stepValue.String()), args, argRanges, nil
 
 }
 
@@ -18,7 +18,6 @@
 package parser
 
 import (
-	"encoding/csv"
 	"fmt"
 	"github.com/getgauge/gauge/gauge_messages"
 	"strings"
@@ -28,6 +27,7 @@ type Table struct {
 	headerIndexMap map[string]int
 	columns        [][]TableCell
 	Headers        []string
+	HeaderSpans    []Span
 	LineNo         int
 }
 
@@ -41,6 +41,7 @@ type DataTable struct {
 type TableCell struct {
 	Value    string
 	CellType ArgType
+	Span     Span
 }
 
 func (table *Table) IsInitialized() bool {
@@ -100,7 +101,14 @@ func (table *Table) AddHeaders(columnNames []string) {
 }
 
 func (table *Table) AddRowValues(rowValues []string) {
+	table.addRowValuesAt(rowValues, nil)
+}
+
+func (table *Table) addRowValuesAt(rowValues []string, spans []Span) {
 	tableCells := table.createTableCells(rowValues)
+	for i := range tableCells {
+		tableCells[i].Span = spanAt(spans, i)
+	}
 	table.addRows(tableCells)
 }
 
@@ -153,6 +161,18 @@ func (table *Table) Rows() [][]string {
 	return tableRows
 }
 
+func (table *Table) rowSpans(index int) []*gauge_messages.Span {
+	var spans []*gauge_messages.Span
+	for _, header := range table.Headers {
+		span := table.Get(header)[index].Span
+		if span.StartLine == 0 {
+			return nil
+		}
+		spans = append(spans, convertToProtoSpan(span))
+	}
+	return spans
+}
+
 func (table *Table) GetRowCount() int {
 	if table.IsInitialized() {
 		return len(table.columns[0])
@@ -187,19 +207,21 @@ func TableFrom(protoTable *gauge_messages.ProtoTable) *Table {
 }
 
 func convertCsvToTable(csvContents string) (*Table, error) {
-	r := csv.NewReader(strings.NewReader(csvContents))
-	r.Comment = '#'
-	lines, err := r.ReadAll()
+	lines, err := readCsvTable(csvContents)
 	if err != nil {
 		return nil, err
 	}
+	return tableFromRows(lines), nil
+}
+
+func tableFromRows(rows [][]string) *Table {
 	table := new(Table)
-	for i, line := range lines {
+	for i, row := range rows {
 		if i == 0 {
-			table.AddHeaders(line)
+			table.AddHeaders(row)
 		} else {
-			table.AddRowValues(line)
+			table.AddRowValues(row)
 		}
 	}
-	return table, nil
+	return table
 }
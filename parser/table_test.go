@@ -79,8 +79,8 @@ func (s *MySuite) TestShouldAddRows(c *C) {
 	var table Table
 
 	table.AddHeaders([]string{"one", "two", "three"})
-	table.addRows([]TableCell{TableCell{"foo", Static}, TableCell{"bar", Static}, TableCell{"baz", Static}})
-	table.addRows([]TableCell{TableCell{"john", Static}, TableCell{"jim", Static}})
+	table.addRows([]TableCell{TableCell{Value: "foo", CellType: Static}, TableCell{Value: "bar", CellType: Static}, TableCell{Value: "baz", CellType: Static}})
+	table.addRows([]TableCell{TableCell{Value: "john", CellType: Static}, TableCell{Value: "jim", CellType: Static}})
 
 	c.Assert(table.GetRowCount(), Equals, 2)
 	column1 := table.Get("one")
@@ -151,9 +151,9 @@ func (s *MySuite) TestValuesBasedOnHeaders(c *C) {
 	var table Table
 	table.AddHeaders([]string{"id", "name"})
 
-	firstRow := table.toHeaderSizeRow([]TableCell{TableCell{"123", Static}, TableCell{"foo", Static}})
-	secondRow := table.toHeaderSizeRow([]TableCell{TableCell{"jim", Static}, TableCell{"jack", Static}})
-	thirdRow := table.toHeaderSizeRow([]TableCell{TableCell{"789", Static}})
+	firstRow := table.toHeaderSizeRow([]TableCell{TableCell{Value: "123", CellType: Static}, TableCell{Value: "foo", CellType: Static}})
+	secondRow := table.toHeaderSizeRow([]TableCell{TableCell{Value: "jim", CellType: Static}, TableCell{Value: "jack", CellType: Static}})
+	thirdRow := table.toHeaderSizeRow([]TableCell{TableCell{Value: "789", CellType: Static}})
 
 	c.Assert(len(firstRow), Equals, 2)
 	c.Assert(firstRow[0].Value, Equals, "123")
@@ -0,0 +1,252 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package parse
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	oldparser "github.com/getgauge/gauge/parser"
+)
+
+// The compatibility tests parse every fixture through both the state machine
+// parser and this one, and expect the same tree.
+
+func TestSpecCompatibility(t *testing.T) {
+	useTestdataAsProjectRoot(t)
+	for _, file := range fixtures(t, "testdata/*.spec") {
+		text := readFixture(t, file)
+		old, result := new(oldparser.SpecParser).Parse(text, oldparser.NewConceptDictionary())
+		if !result.Ok {
+			t.Errorf("%s: parser failed with %s", file, result.Error())
+			continue
+		}
+		want := fromSpec(old)
+		want.FileName = file
+		skipBlankLines(t, file, text, &want)
+
+		got, err := ParseSpec(file, text)
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", file, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: \ngot\n\t%+v\nexpected\n\t%+v", file, got, want)
+		}
+	}
+}
+
+func TestConceptCompatibility(t *testing.T) {
+	useTestdataAsProjectRoot(t)
+	for _, file := range fixtures(t, "testdata/*.cpt") {
+		text := readFixture(t, file)
+		old, result := new(oldparser.ConceptParser).Parse(text)
+		if result != nil && result.Error != nil {
+			t.Errorf("%s: parser failed with %s", file, result.Error)
+			continue
+		}
+		want := make([]gauge.Concept, 0)
+		for _, concept := range old {
+			want = append(want, fromConcept(file, concept))
+		}
+
+		got, err := ParseConcepts(file, text)
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", file, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: \ngot\n\t%+v\nexpected\n\t%+v", file, got, want)
+		}
+	}
+}
+
+func TestSpecErrorCompatibility(t *testing.T) {
+	useTestdataAsProjectRoot(t)
+	for _, file := range fixtures(t, "testdata/invalid/*.spec") {
+		text := readFixture(t, file)
+		_, result := new(oldparser.SpecParser).Parse(text, oldparser.NewConceptDictionary())
+		if result.Ok {
+			t.Errorf("%s: expected parser to fail", file)
+			continue
+		}
+
+		_, err := ParseSpec(file, text)
+		if err == nil {
+			t.Errorf("%s: expected an error", file)
+			continue
+		}
+		if lineNo := err.(*ParseError).LineNo; lineNo != result.ParseError.LineNo {
+			t.Errorf("%s: got error on line %d, parser reported line %d", file, lineNo, result.ParseError.LineNo)
+		}
+	}
+}
+
+func useTestdataAsProjectRoot(t *testing.T) {
+	root, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.ProjectRoot = root
+}
+
+func fixtures(t *testing.T, pattern string) []string {
+	files, err := filepath.Glob(pattern)
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no fixtures found for %s", pattern)
+	}
+	return files
+}
+
+func readFixture(t *testing.T, file string) string {
+	bytes, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(bytes)
+}
+
+func fromSpec(spec *oldparser.Specification) gauge.Spec {
+	result := gauge.Spec{
+		Heading:       fromHeading(spec.Heading),
+		Tags:          fromTags(spec.Tags),
+		Comments:      fromComments(spec.Comments),
+		Contexts:      fromSteps(spec.Contexts),
+		TearDownSteps: fromSteps(spec.TearDownSteps),
+	}
+	if spec.DataTable.IsExternal {
+		source := strings.TrimSpace(strings.TrimPrefix(spec.DataTable.Value, "table:"))
+		result.DataTable = &gauge.DataTable{Table: gauge.Table{LineNo: spec.DataTable.LineNo}, Source: source}
+	} else if spec.DataTable.IsInitialized() {
+		result.DataTable = &gauge.DataTable{Table: fromTable(&spec.DataTable.Table, spec.DataTable.Table.LineNo)}
+	}
+	for _, scenario := range spec.Scenarios {
+		result.Scenarios = append(result.Scenarios, gauge.Scenario{
+			Heading:  fromHeading(scenario.Heading),
+			Tags:     fromTags(scenario.Tags),
+			Comments: fromComments(scenario.Comments),
+			Steps:    fromSteps(scenario.Steps),
+		})
+	}
+	return result
+}
+
+func fromConcept(file string, concept *oldparser.Step) gauge.Concept {
+	result := gauge.Concept{
+		FileName: file,
+		LineNo:   concept.LineNo,
+		Heading:  concept.LineText,
+		Value:    concept.Value,
+		Steps:    fromSteps(concept.ConceptSteps),
+	}
+	for _, arg := range concept.Args {
+		result.Params = append(result.Params, arg.Value)
+	}
+	return result
+}
+
+func fromHeading(heading *oldparser.Heading) gauge.Heading {
+	return gauge.Heading{LineNo: heading.LineNo, Text: heading.Value}
+}
+
+func fromTags(tags *oldparser.Tags) []string {
+	if tags == nil {
+		return nil
+	}
+	return tags.Values
+}
+
+func fromComments(comments []*oldparser.Comment) []gauge.Comment {
+	var result []gauge.Comment
+	for _, comment := range comments {
+		result = append(result, gauge.Comment{LineNo: comment.LineNo, Text: comment.Value})
+	}
+	return result
+}
+
+// skipBlankLines removes the comments the state machine parser makes of blank lines, which this parser does not
+// report. This is the only difference the compatibility tests allow, any other "\n" comment fails the test.
+func skipBlankLines(t *testing.T, file, text string, spec *gauge.Spec) {
+	lines := strings.Split(text, "\n")
+	skip := func(comments []gauge.Comment) []gauge.Comment {
+		var result []gauge.Comment
+		for _, comment := range comments {
+			if comment.Text != "\n" {
+				result = append(result, comment)
+			} else if comment.LineNo > len(lines) || strings.TrimSpace(lines[comment.LineNo-1]) != "" {
+				t.Errorf("%s: parser reported a blank line comment on line %d, which is not blank", file, comment.LineNo)
+			}
+		}
+		return result
+	}
+	spec.Comments = skip(spec.Comments)
+	for i := range spec.Scenarios {
+		spec.Scenarios[i].Comments = skip(spec.Scenarios[i].Comments)
+	}
+}
+
+func fromSteps(steps []*oldparser.Step) []gauge.Step {
+	var result []gauge.Step
+	for _, step := range steps {
+		result = append(result, fromStep(step))
+	}
+	return result
+}
+
+func fromStep(step *oldparser.Step) gauge.Step {
+	result := gauge.Step{LineNo: step.LineNo, ActualText: step.LineText, Value: step.Value}
+	for _, arg := range step.Args {
+		var stepArg gauge.StepArg
+		switch arg.ArgType {
+		case oldparser.Static:
+			stepArg = gauge.StepArg{Type: gauge.Static, Value: arg.Value}
+		case oldparser.Dynamic:
+			stepArg = gauge.StepArg{Type: gauge.Dynamic, Value: arg.Value}
+		case oldparser.SpecialString, oldparser.SpecialTable:
+			stepArg = gauge.StepArg{Type: gauge.Special, Value: arg.Name}
+		case oldparser.TableArg:
+			// Inline tables do not record their line, they always start on the line after the step.
+			table := fromTable(&arg.Table, step.LineNo+1)
+			stepArg = gauge.StepArg{Type: gauge.TableArg, Table: &table}
+		}
+		result.Args = append(result.Args, stepArg)
+	}
+	return result
+}
+
+func fromTable(table *oldparser.Table, lineNo int) gauge.Table {
+	result := gauge.Table{LineNo: lineNo, Headers: table.Headers}
+	for i := 0; i < table.GetRowCount(); i++ {
+		row := make([]gauge.Cell, 0)
+		for _, header := range table.Headers {
+			cell := table.Get(header)[i]
+			cellType := gauge.Static
+			if cell.CellType == oldparser.Dynamic {
+				cellType = gauge.Dynamic
+			}
+			row = append(row, gauge.Cell{Value: cell.Value, Type: cellType})
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	return result
+}
@@ -19,6 +19,7 @@ package parse
 
 import (
 	"fmt"
+	"strings"
 	"unicode/utf8"
 )
 
@@ -43,6 +44,15 @@ const (
 	itemH1Hash
 	itemDoubleUnderline
 	itemAsterisk
+	itemH2Hash
+	itemUnderline
+	itemTearDown
+	itemTags
+	itemTableKeyword
+	itemPipe
+	itemStaticParam  // "value", quotes included
+	itemDynamicParam // <name>, brackets included
+	itemSpecialParam // <type:value>, brackets included
 )
 
 const (
@@ -64,9 +74,19 @@ type lexer struct {
 }
 
 func NewLexer(name, input string) *lexer {
+	return newLexer(name, input, lexStart)
+}
+
+// newStepLexer scans input as the text of a single step, e.g. a concept heading.
+func newStepLexer(name, input string) *lexer {
+	return newLexer(name, input, lexStep)
+}
+
+func newLexer(name, input string, start stateFn) *lexer {
 	l := &lexer{
 		name:       name,
 		input:      input,
+		state:      start,
 		currentPos: 0,
 		start:      0,
 		items:      make(chan item),
@@ -75,20 +95,10 @@ func NewLexer(name, input string) *lexer {
 	return l
 }
 
-// // line returns the current line in the input.
-// func (l *lexer) line() string {
-// 	var r rune
-// 	for r != eof && r != '\n' && r != '\r' {
-// 		r = l.rune()
-// 	}
-// 	currLine := l.input[l.start:l.currentPos]
-// 	l.start = l.currentPos
-// 	return currLine
-// }
-
 // rune returns the next rune in the input.
 func (l *lexer) rune() rune {
 	if l.currentPos >= len(l.input) {
+		l.width = 0
 		return eof
 	}
 	r, w := utf8.DecodeRuneInString(l.input[l.currentPos:])
@@ -100,9 +110,7 @@ func (l *lexer) rune() rune {
 // peek returns but does not consume the next rune in the input.
 func (l *lexer) peek() rune {
 	r := l.rune()
-	if r != eof {
-		l.backup()
-	}
+	l.backup()
 	return r
 }
 
@@ -111,18 +119,9 @@ func (l *lexer) backup() {
 	l.currentPos -= l.width
 }
 
-// // peekNextLine returns the next line, without consuming it.
-// func (l *lexer) peekNextLine() string {
-// 	currLexerPos := l.currentPos
-// 	currLexerStart := l.start
-
-// 	currLine := l.line()
-// 	currLine = l.line()
-
-// 	l.currentPos = currLexerPos
-// 	l.start = currLexerStart
-// 	return currLine
-// }
+func (l *lexer) ignore() {
+	l.start = l.currentPos
+}
 
 // emit passes an item back to the client.
 func (l *lexer) emit(t itemType) {
@@ -130,76 +129,134 @@ func (l *lexer) emit(t itemType) {
 	l.start = l.currentPos
 }
 
+func (l *lexer) emitText() {
+	if l.currentPos > l.start {
+		l.emit(itemText)
+	}
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) stateFn {
+	l.items <- item{itemError, l.start, fmt.Sprintf(format, args...)}
+	return nil
+}
+
 // nextItem returns the next item from the input.
 func (l *lexer) nextItem() item {
 	item := <-l.items
 	return item
 }
 
-// TODO: don't like this func name. need to change it
-func (l *lexer) hasUnemittedText() bool {
-	return l.currentPos-l.start > 1
+// drain consumes the remaining items so that the lexing goroutine can exit.
+func (l *lexer) drain() {
+	for range l.items {
+	}
 }
 
 // run runs the state machine for the lexer.
 func (l *lexer) run() {
-	for l.state = lexStart; l.state != nil; {
+	for l.state != nil {
 		l.state = l.state(l)
 	}
 	close(l.items)
 }
 
-func lexStart(l *lexer) stateFn {
-	r := l.rune()
-	for isSpace(r) || isNewLine(r) {
-		r = l.rune()
-		l.start = l.currentPos
+// line returns the rest of the current line, without the line ending.
+func (l *lexer) line() string {
+	rest := l.input[l.currentPos:]
+	if i := strings.IndexAny(rest, "\r\n"); i >= 0 {
+		return rest[:i]
 	}
+	return rest
+}
 
-	switch {
-	case isH1Hash(r):
-		return lexH1Hash
-	default:
-		l.backup()
-		return lexText
+// skipEscaped consumes the rune following a backslash, unless it ends the line.
+func (l *lexer) skipEscaped() {
+	if r := l.peek(); !isEOF(r) && !isNewLine(r) {
+		l.rune()
 	}
-	return nil
 }
 
-func lexText(l *lexer) stateFn {
-	r := l.rune()
-	for !isEOF(r) {
-		switch {
-		case isDoubleUnderline(r):
-			return lexDoubleUnderline
-		case isAsterisk(r):
-			return lexAsterisk
-		case isNewLine(r):
-			if l.hasUnemittedText() {
-				l.backup()
-				l.emit(itemText)
-				l.rune()
-			}
-			return lexNewLine
-		}
+func lexStart(l *lexer) stateFn {
+	for r := l.peek(); isSpace(r) || isNewLine(r); r = l.peek() {
+		l.rune()
+	}
+	l.ignore()
+	return lexLineStart
+}
 
-		r = l.rune()
+// lexLineStart decides what a line holds from its first non blank characters.
+func lexLineStart(l *lexer) stateFn {
+	line := l.line()
+	trimmed := strings.TrimSpace(line)
+	if len(trimmed) == 0 {
+		l.currentPos += len(line)
+		l.ignore()
+		return lexEndOfLine
 	}
 
-	if l.hasUnemittedText() {
-		l.emit(itemText)
+	indent := len(line) - len(strings.TrimLeft(line, " \t"))
+	marker := func(t itemType, width int) {
+		l.currentPos += indent
+		l.ignore()
+		l.currentPos += width
+		l.emit(t)
+	}
+	underline := func(t itemType) stateFn {
+		marker(t, len(trimmed))
+		l.currentPos += len(line) - indent - len(trimmed)
+		l.ignore()
+		return lexEndOfLine
 	}
-	return lexEof
-}
 
-func lexAsterisk(l *lexer) stateFn {
-	l.emit(itemAsterisk)
+	switch {
+	case strings.HasPrefix(trimmed, "###"):
+		return lexText
+	case strings.HasPrefix(trimmed, "##"):
+		marker(itemH2Hash, 2)
+		return lexText
+	case isH1Hash(rune(trimmed[0])):
+		marker(itemH1Hash, 1)
+		return lexText
+	case isLineOf(trimmed, '='):
+		return underline(itemDoubleUnderline)
+	case isLineOf(trimmed, '-'):
+		return underline(itemUnderline)
+	case isLineOf(trimmed, '_'):
+		return underline(itemTearDown)
+	case isAsterisk(rune(trimmed[0])) && !strings.HasPrefix(trimmed, "**"):
+		marker(itemAsterisk, 1)
+		return lexStep
+	case isPipe(rune(trimmed[0])) && isPipe(rune(trimmed[len(trimmed)-1])):
+		l.currentPos += indent
+		l.ignore()
+		return lexTableRow
+	}
+	if width := keywordWidth(trimmed, "tags"); width > 0 {
+		marker(itemTags, width)
+		return lexText
+	}
+	if width := keywordWidth(trimmed, "table"); width > 0 {
+		marker(itemTableKeyword, width)
+		return lexText
+	}
 	return lexText
 }
 
-func lexH1Hash(l *lexer) stateFn {
-	l.emit(itemH1Hash)
-	return lexText
+// lexText scans the rest of the line as plain text.
+func lexText(l *lexer) stateFn {
+	l.currentPos += len(l.line())
+	l.emitText()
+	return lexEndOfLine
+}
+
+func lexEndOfLine(l *lexer) stateFn {
+	switch r := l.rune(); {
+	case isEOF(r):
+		return lexEof
+	case r == '\r' && l.peek() == '\n':
+		l.rune()
+	}
+	return lexNewLine
 }
 
 func lexEof(l *lexer) stateFn {
@@ -209,20 +266,104 @@ func lexEof(l *lexer) stateFn {
 
 func lexNewLine(l *lexer) stateFn {
 	l.emit(itemNewline)
-	return lexText
+	return lexLineStart
 }
 
-func lexDoubleUnderline(l *lexer) stateFn {
-	for isDoubleUnderline(l.peek()) {
-		l.rune()
+// lexStep scans step text, splitting out its static, dynamic and special params.
+func lexStep(l *lexer) stateFn {
+	for {
+		switch r := l.peek(); {
+		case isEOF(r) || isNewLine(r):
+			l.emitText()
+			return lexEndOfLine
+		case r == '\\':
+			l.rune()
+			l.skipEscaped()
+		case r == '"':
+			l.emitText()
+			return lexStaticParam
+		case r == '<':
+			l.emitText()
+			return lexDynamicParam
+		case r == '{' || r == '}':
+			return l.errorf("'%c' is a reserved character and should be escaped", r)
+		default:
+			l.rune()
+		}
+	}
+}
+
+func lexStaticParam(l *lexer) stateFn {
+	l.rune()
+	for {
+		switch r := l.rune(); {
+		case r == '\\':
+			l.skipEscaped()
+		case r == '"':
+			l.emit(itemStaticParam)
+			return lexStep
+		case isEOF(r) || isNewLine(r):
+			return l.errorf("String not terminated")
+		}
+	}
+}
+
+func lexDynamicParam(l *lexer) stateFn {
+	l.rune()
+	typ := itemDynamicParam
+	for {
+		switch r := l.rune(); {
+		case r == '\\':
+			l.skipEscaped()
+		case r == ':':
+			typ = itemSpecialParam
+		case r == '>':
+			l.emit(typ)
+			return lexStep
+		case isEOF(r) || isNewLine(r):
+			return l.errorf("Dynamic parameter not terminated")
+		}
+	}
+}
+
+// lexTableRow scans a table row into pipes and the cell text between them.
+func lexTableRow(l *lexer) stateFn {
+	for {
+		switch r := l.peek(); {
+		case isPipe(r):
+			l.emitText()
+			l.rune()
+			l.emit(itemPipe)
+		case r == '\\':
+			l.rune()
+			l.skipEscaped()
+		case isEOF(r) || isNewLine(r):
+			l.ignore()
+			return lexEndOfLine
+		default:
+			l.rune()
+		}
 	}
-	l.emit(itemDoubleUnderline)
-	return lexText
 }
 
 // ---------------------------------------------
 // helper funcs
 
+// keywordWidth returns the length of a leading "keyword:" or "keyword :", or 0.
+func keywordWidth(text, keyword string) int {
+	lowerCased := strings.ToLower(text)
+	for _, prefix := range []string{keyword + ":", keyword + " :"} {
+		if strings.HasPrefix(lowerCased, prefix) {
+			return len(prefix)
+		}
+	}
+	return 0
+}
+
+func isLineOf(text string, r rune) bool {
+	return len(strings.Trim(text, string(r))) == 0
+}
+
 func isEOF(r rune) bool {
 	return r == eof
 }
@@ -235,16 +376,12 @@ func isNewLine(r rune) bool {
 	return r == '\n' || r == '\r'
 }
 
-func isGaugeParamChar(r rune) bool {
-	return r == '"' || r == '<' || r == '>'
-}
-
 func isAsterisk(r rune) bool {
 	return r == '*'
 }
 
-func isDoubleUnderline(r rune) bool {
-	return r == '='
+func isPipe(r rune) bool {
+	return r == '|'
 }
 
 func isH1Hash(r rune) bool {
@@ -157,6 +157,63 @@ var lexTests = []lexTest{
 		{itemNewline, 0, "\n"},
 		tEOF,
 	}},
+	{"scenario heading with tags", "## Scenario heading\ntags : a, b", []item{
+		{itemH2Hash, 0, "##"},
+		{itemText, 0, " Scenario heading"},
+		{itemNewline, 0, "\n"},
+		{itemTags, 0, "tags :"},
+		{itemText, 0, " a, b"},
+		tEOF,
+	}},
+	{"step with params", "* Say \"hello\" to <name> with <file:a.txt>\r\n", []item{
+		{itemAsterisk, 0, "*"},
+		{itemText, 0, " Say "},
+		{itemStaticParam, 0, "\"hello\""},
+		{itemText, 0, " to "},
+		{itemDynamicParam, 0, "<name>"},
+		{itemText, 0, " with "},
+		{itemSpecialParam, 0, "<file:a.txt>"},
+		{itemNewline, 0, "\r\n"},
+		tEOF,
+	}},
+	{"step with escaped quote", "* A \\\"quote", []item{
+		{itemAsterisk, 0, "*"},
+		{itemText, 0, " A \\\"quote"},
+		tEOF,
+	}},
+	{"unterminated dynamic param", "* Greet <name", []item{
+		{itemAsterisk, 0, "*"},
+		{itemText, 0, " Greet "},
+		{itemError, 0, "Dynamic parameter not terminated"},
+	}},
+	{"indented table", "   |id| na\\|me |\n   |--|------|", []item{
+		{itemPipe, 0, "|"},
+		{itemText, 0, "id"},
+		{itemPipe, 0, "|"},
+		{itemText, 0, " na\\|me "},
+		{itemPipe, 0, "|"},
+		{itemNewline, 0, "\n"},
+		{itemPipe, 0, "|"},
+		{itemText, 0, "--"},
+		{itemPipe, 0, "|"},
+		{itemText, 0, "------"},
+		{itemPipe, 0, "|"},
+		tEOF,
+	}},
+	{"data table keyword and teardown", "Table: users.csv\n____\n", []item{
+		{itemTableKeyword, 0, "Table:"},
+		{itemText, 0, " users.csv"},
+		{itemNewline, 0, "\n"},
+		{itemTearDown, 0, "____"},
+		{itemNewline, 0, "\n"},
+		tEOF,
+	}},
+	{"comment line keeps markers in text", "### Not a heading\n  A * b = c", []item{
+		{itemText, 0, "### Not a heading"},
+		{itemNewline, 0, "\n"},
+		{itemText, 0, "  A * b = c"},
+		tEOF,
+	}},
 }
 
 func itemEquals(slice1, slice2 []item) bool {
@@ -180,7 +237,7 @@ func collect(t *lexTest) (items []item) {
 	for {
 		item := l.nextItem()
 		items = append(items, item)
-		if item.typ == itemEOF {
+		if item.typ == itemEOF || item.typ == itemError {
 			break
 		}
 	}
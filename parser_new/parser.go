@@ -18,9 +18,36 @@
 package parse
 
 import (
+	"bytes"
+	"fmt"
 	"strings"
 
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
+)
+
+const paramPlaceholder = "{}"
+
+var stepEscapes = map[rune]rune{'t': '\t', 'n': '\n'}
+
+// ParseError reports text that does not follow the spec or concept grammar.
+type ParseError struct {
+	FileName string
+	LineNo   int
+	Message  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s:%d %s", e.FileName, e.LineNo, e.Message)
+}
+
+type scope int
+
+const (
+	beforeHeading scope = iota
+	inSpec
+	inScenario
+	inTearDown
 )
 
 type parser struct {
@@ -30,6 +57,15 @@ type parser struct {
 	lex       *lexer
 	token     [3]item // three-token lookahead for parser.
 	peekCount int
+	line      int // line number of linePos
+	linePos   int
+	// Grammar state
+	scope       scope
+	lastStep    *gauge.Step       // step that a table on the next line belongs to
+	table       *gauge.Table      // table receiving rows
+	inlineTable bool              // whether table is the table arg of a step
+	commentRows bool              // rows of an ignored table are kept as comments
+	lookup      func(string) bool // reports whether a dynamic param can be resolved
 }
 
 func (p *parser) next() item {
@@ -38,16 +74,24 @@ func (p *parser) next() item {
 	} else {
 		p.token[0] = p.lex.nextItem()
 	}
-	return p.token[p.peekCount]
+	return p.checked(p.token[p.peekCount])
 }
 
 func (p *parser) peek() item {
 	if p.peekCount > 0 {
-		return p.token[p.peekCount-1]
+		return p.checked(p.token[p.peekCount-1])
 	}
 	p.peekCount = 1
 	p.token[0] = p.lex.nextItem()
-	return p.token[0]
+	return p.checked(p.token[0])
+}
+
+// checked reports lexing errors as parse errors.
+func (p *parser) checked(t item) item {
+	if t.typ == itemError {
+		p.errorf(p.lineOf(t.pos), "%s", t.val)
+	}
+	return t
 }
 
 func New(name, text string) *parser {
@@ -55,45 +99,492 @@ func New(name, text string) *parser {
 		name: name,
 		text: text,
 		lex:  NewLexer(name, text),
+		line: 1,
+	}
+}
+
+func (p *parser) errorf(lineNo int, format string, args ...interface{}) {
+	panic(&ParseError{FileName: p.name, LineNo: lineNo, Message: fmt.Sprintf(format, args...)})
+}
+
+// recover turns a ParseError panic into an error returned by the top level parse function.
+func (p *parser) recover(errp *error) {
+	e := recover()
+	if e == nil {
+		return
+	}
+	pe, ok := e.(*ParseError)
+	if !ok {
+		panic(e)
 	}
+	p.lex.drain()
+	*errp = pe
 }
 
-func ParseConcept(filename, text string) gauge.Concept {
+// lineOf returns the line number of an input position.
+func (p *parser) lineOf(pos int) int {
+	if pos < p.linePos {
+		p.line, p.linePos = 1, 0
+	}
+	p.line += strings.Count(p.text[p.linePos:pos], "\n")
+	p.linePos = pos
+	return p.line
+}
+
+// rawLine returns the input line starting at pos.
+func (p *parser) rawLine(pos int) string {
+	line := p.text[pos:]
+	if i := strings.IndexAny(line, "\r\n"); i >= 0 {
+		line = line[:i]
+	}
+	return strings.TrimRight(line, " \t")
+}
+
+// lineText consumes the rest of the line and returns its text.
+func (p *parser) lineText() string {
+	var text bytes.Buffer
+	for t := p.peek(); t.typ != itemNewline && t.typ != itemEOF; t = p.peek() {
+		text.WriteString(p.next().val)
+	}
+	p.endLine()
+	return text.String()
+}
+
+func (p *parser) endLine() {
+	if p.peek().typ == itemNewline {
+		p.next()
+	}
+}
+
+// underlined consumes a setext underline of the given type following a text line.
+func (p *parser) underlined(typ itemType) bool {
+	if p.peek().typ != typ {
+		return false
+	}
+	p.next()
+	p.endLine()
+	return true
+}
+
+func ParseSpec(filename, text string) (spec gauge.Spec, err error) {
 	p := New(filename, text)
-	cptHeading := p.parseConceptHeading()
-	steps := p.parseSteps()
+	defer p.recover(&err)
+	spec = p.parseSpec()
+	return
+}
 
-	return gauge.Concept{
-		FileName: filename,
-		LineNo:   0,
-		Heading:  cptHeading,
-		Steps:    steps,
+func (p *parser) parseSpec() gauge.Spec {
+	spec := &gauge.Spec{FileName: p.name}
+	p.lookup = func(name string) bool {
+		if spec.DataTable == nil {
+			return false
+		}
+		// Headers of an external table are known only once the file is read.
+		return spec.DataTable.Source != "" || contains(spec.DataTable.Headers, name)
+	}
+	for p.peek().typ != itemEOF {
+		p.parseSpecLine(spec)
+	}
+	if p.scope == beforeHeading {
+		p.errorf(1, "Spec heading not found")
+	}
+	if dt := spec.DataTable; dt != nil && dt.Source == "" && len(dt.Rows) == 0 {
+		p.errorf(dt.LineNo, "Data table should have at least 1 data row")
+	}
+	return *spec
+}
+
+func (p *parser) parseSpecLine(spec *gauge.Spec) {
+	t := p.next()
+	lineNo := p.lineOf(t.pos)
+	if t.typ != itemPipe {
+		p.table = nil
+		p.commentRows = false
+	}
+	if t.typ != itemAsterisk && t.typ != itemPipe {
+		p.lastStep = nil
+	}
+
+	switch t.typ {
+	case itemNewline:
+	case itemH1Hash:
+		p.specHeading(spec, lineNo, p.lineText())
+	case itemH2Hash:
+		p.scenarioHeading(spec, lineNo, p.lineText())
+	case itemText:
+		p.endLine()
+		switch {
+		case p.underlined(itemDoubleUnderline):
+			p.specHeading(spec, lineNo, t.val)
+		case p.underlined(itemUnderline):
+			p.scenarioHeading(spec, lineNo, t.val)
+		default:
+			p.addComment(spec, lineNo, t.val)
+		}
+	case itemAsterisk:
+		p.specStep(spec, p.parseStep(lineNo))
+	case itemTags:
+		p.specTags(spec, splitTags(p.lineText()))
+	case itemTableKeyword:
+		p.externalTable(spec, lineNo, t, p.lineText())
+	case itemTearDown:
+		p.endLine()
+		p.tearDown(spec, lineNo, t.val)
+	case itemPipe:
+		p.specTableRow(spec, lineNo, t)
+	default:
+		p.endLine()
+		p.addComment(spec, lineNo, t.val)
+	}
+}
+
+func (p *parser) specHeading(spec *gauge.Spec, lineNo int, text string) {
+	text = strings.TrimSpace(text)
+	if len(text) == 0 {
+		p.errorf(lineNo, "Spec heading should have at least one character")
+	}
+	if p.scope != beforeHeading {
+		p.errorf(lineNo, "Multiple spec headings found in same file")
+	}
+	spec.Heading = gauge.Heading{LineNo: lineNo, Text: text}
+	p.scope = inSpec
+}
+
+func (p *parser) scenarioHeading(spec *gauge.Spec, lineNo int, text string) {
+	text = strings.TrimSpace(text)
+	if len(text) == 0 {
+		p.errorf(lineNo, "Scenario heading should have at least one character")
+	}
+	if p.scope == beforeHeading {
+		p.errorf(lineNo, "Scenario should be defined after the spec heading")
+	}
+	for _, scenario := range spec.Scenarios {
+		if strings.EqualFold(scenario.Heading.Text, text) {
+			p.errorf(lineNo, "Duplicate scenario definition '%s' found in the same specification", scenario.Heading.Text)
+		}
+	}
+	spec.Scenarios = append(spec.Scenarios, gauge.Scenario{Heading: gauge.Heading{LineNo: lineNo, Text: text}})
+	p.scope = inScenario
+}
+
+func (p *parser) specStep(spec *gauge.Spec, step gauge.Step) {
+	var steps *[]gauge.Step
+	switch p.scope {
+	case beforeHeading:
+		p.errorf(step.LineNo, "Step should be defined after the spec heading")
+	case inSpec:
+		steps = &spec.Contexts
+	case inScenario:
+		steps = &spec.Scenarios[len(spec.Scenarios)-1].Steps
+	case inTearDown:
+		steps = &spec.TearDownSteps
+	}
+	*steps = append(*steps, step)
+	p.lastStep = &(*steps)[len(*steps)-1]
+}
+
+func (p *parser) specTags(spec *gauge.Spec, tags []string) {
+	if p.scope == inScenario {
+		spec.Scenarios[len(spec.Scenarios)-1].Tags = tags
+	} else {
+		spec.Tags = tags
+	}
+}
+
+func (p *parser) externalTable(spec *gauge.Spec, lineNo int, keyword item, source string) {
+	source = strings.TrimSpace(source)
+	if len(source) == 0 {
+		p.errorf(lineNo, "Table location not specified")
+	}
+	if p.scope == beforeHeading || spec.DataTable != nil {
+		p.addComment(spec, lineNo, p.rawLine(keyword.pos))
+		return
+	}
+	spec.DataTable = &gauge.DataTable{Table: gauge.Table{LineNo: lineNo}, Source: source}
+}
+
+func (p *parser) tearDown(spec *gauge.Spec, lineNo int, text string) {
+	if len(text) < 3 {
+		p.errorf(lineNo, "Teardown should have at least three character")
+	}
+	if p.scope == beforeHeading {
+		p.addComment(spec, lineNo, text)
+		return
 	}
+	p.scope = inTearDown
 }
 
-func (p *parser) parseConceptHeading() string {
-	token := p.next()
-	var heading string
+func (p *parser) specTableRow(spec *gauge.Spec, lineNo int, pipe item) {
+	cells := p.tableCells()
 	switch {
-	case token.typ == itemH1Hash:
-		heading = strings.TrimSpace(p.next().val)
+	case p.table != nil:
+		p.addTableRow(cells)
+	case p.commentRows:
+		p.addComment(spec, lineNo, p.rawLine(pipe.pos))
+	case p.lastStep != nil:
+		p.addInlineTable(lineNo, cells)
+	case p.scope == inSpec && spec.DataTable == nil:
+		spec.DataTable = &gauge.DataTable{Table: p.newTable(lineNo, cells)}
+		p.table, p.inlineTable = &spec.DataTable.Table, false
 	default:
-		heading = strings.TrimSpace(token.val)
+		p.newTable(lineNo, cells)
+		p.commentRows = true
+		p.addComment(spec, lineNo, p.rawLine(pipe.pos))
 	}
-	p.next()
-	return heading
 }
 
-func (p *parser) parseSteps() []gauge.Step {
-	steps := make([]gauge.Step, 0)
+func (p *parser) addComment(spec *gauge.Spec, lineNo int, text string) {
+	comment := gauge.Comment{LineNo: lineNo, Text: strings.TrimRight(text, " \t")}
+	if p.scope == inScenario {
+		scenario := &spec.Scenarios[len(spec.Scenarios)-1]
+		scenario.Comments = append(scenario.Comments, comment)
+	} else {
+		spec.Comments = append(spec.Comments, comment)
+	}
+}
+
+// ParseConcept returns the first concept defined in text.
+func ParseConcept(filename, text string) (gauge.Concept, error) {
+	concepts, err := ParseConcepts(filename, text)
+	if err != nil || len(concepts) == 0 {
+		return gauge.Concept{}, err
+	}
+	return concepts[0], nil
+}
+
+func ParseConcepts(filename, text string) (concepts []gauge.Concept, err error) {
+	p := New(filename, text)
+	defer p.recover(&err)
+	concepts = p.parseConcepts()
+	return
+}
+
+func (p *parser) parseConcepts() []gauge.Concept {
+	concepts := make([]gauge.Concept, 0)
 	for p.peek().typ != itemEOF {
-		token := p.next()
-		if token.typ == itemAsterisk {
-			steps = append(steps, gauge.Step{
-				LineNo:     0,
-				ActualText: strings.TrimSpace(p.next().val),
-			})
+		t := p.next()
+		lineNo := p.lineOf(t.pos)
+		if t.typ != itemPipe {
+			p.table = nil
+		}
+		if t.typ != itemAsterisk && t.typ != itemPipe {
+			p.lastStep = nil
+		}
+
+		switch t.typ {
+		case itemNewline:
+		case itemH1Hash, itemH2Hash:
+			concepts = append(concepts, p.parseConceptHeading(lineNo, p.lineText()))
+		case itemText:
+			p.endLine()
+			if p.underlined(itemDoubleUnderline) || p.underlined(itemUnderline) {
+				concepts = append(concepts, p.parseConceptHeading(lineNo, t.val))
+			}
+		case itemAsterisk:
+			step := p.parseStep(lineNo)
+			if len(concepts) == 0 {
+				p.errorf(lineNo, "Step is not defined inside a concept heading")
+			}
+			concept := &concepts[len(concepts)-1]
+			if step.Value == concept.Value {
+				p.errorf(lineNo, "Cyclic dependancy found. Step is calling concept again.")
+			}
+			concept.Steps = append(concept.Steps, step)
+			p.lastStep = &concept.Steps[len(concept.Steps)-1]
+		case itemPipe:
+			cells := p.tableCells()
+			switch {
+			case p.table != nil:
+				p.addTableRow(cells)
+			case p.lastStep != nil:
+				p.addInlineTable(lineNo, cells)
+			default:
+				p.errorf(lineNo, "Table doesn't belong to any step")
+			}
+		default:
+			p.lineText()
+		}
+	}
+	for _, concept := range concepts {
+		if len(concept.Steps) == 0 {
+			p.errorf(concept.LineNo, "Concept should have atleast one step")
+		}
+	}
+	return concepts
+}
+
+func (p *parser) parseConceptHeading(lineNo int, text string) gauge.Concept {
+	heading := strings.TrimSpace(text)
+	if len(heading) == 0 {
+		p.errorf(lineNo, "Concept heading should have at least one character")
+	}
+	headingParser := &parser{name: p.name, text: heading, lex: newStepLexer(p.name, heading), line: lineNo}
+	defer headingParser.lex.drain()
+	step := headingParser.parseStep(lineNo)
+	var params []string
+	for _, arg := range step.Args {
+		if arg.Type != gauge.Dynamic {
+			p.errorf(lineNo, "Concept heading can have only Dynamic Parameters")
+		}
+		params = append(params, arg.Value)
+	}
+	p.lookup = func(name string) bool {
+		return contains(params, name)
+	}
+	return gauge.Concept{
+		FileName: p.name,
+		LineNo:   lineNo,
+		Heading:  heading,
+		Value:    step.Value,
+		Params:   params,
+	}
+}
+
+// parseStep parses the text of a step, following its leading asterisk.
+func (p *parser) parseStep(lineNo int) gauge.Step {
+	var value, actualText bytes.Buffer
+	var args []gauge.StepArg
+	for t := p.peek(); isStepItem(t.typ); t = p.peek() {
+		p.next()
+		actualText.WriteString(t.val)
+		if t.typ == itemText {
+			value.WriteString(unescape(t.val, stepEscapes))
+			continue
+		}
+		value.WriteString(paramPlaceholder)
+		param := unescape(t.val[1:len(t.val)-1], stepEscapes)
+		switch {
+		case t.typ == itemStaticParam:
+			args = append(args, gauge.StepArg{Type: gauge.Static, Value: param})
+		case t.typ == itemSpecialParam && util.IsSpecialParamType(strings.TrimSpace(strings.SplitN(param, ":", 2)[0])):
+			args = append(args, gauge.StepArg{Type: gauge.Special, Value: param})
+		default:
+			if p.lookup != nil && !p.lookup(param) {
+				p.errorf(lineNo, "Dynamic parameter <%s> could not be resolved", param)
+			}
+			args = append(args, gauge.StepArg{Type: gauge.Dynamic, Value: param})
+		}
+	}
+	p.endLine()
+
+	step := gauge.Step{
+		LineNo:     lineNo,
+		ActualText: strings.TrimSpace(actualText.String()),
+		Value:      strings.TrimSpace(value.String()),
+		Args:       args,
+	}
+	if len(step.ActualText) == 0 {
+		p.errorf(lineNo, "Step should not be blank")
+	}
+	return step
+}
+
+// tableCells consumes the rest of a table row and returns its cell values.
+func (p *parser) tableCells() []string {
+	cells := make([]string, 0)
+	var cell bytes.Buffer
+	for t := p.peek(); t.typ == itemText || t.typ == itemPipe; t = p.peek() {
+		p.next()
+		if t.typ == itemText {
+			cell.WriteString(t.val)
+			continue
+		}
+		cells = append(cells, strings.TrimSpace(unescape(cell.String(), nil)))
+		cell.Reset()
+	}
+	p.endLine()
+	return cells
+}
+
+func (p *parser) newTable(lineNo int, headers []string) gauge.Table {
+	for i, header := range headers {
+		if len(header) == 0 {
+			p.errorf(lineNo, "Table header should not be blank")
+		}
+		if contains(headers[:i], header) {
+			p.errorf(lineNo, "Table header cannot have repeated column values")
+		}
+	}
+	return gauge.Table{LineNo: lineNo, Headers: headers}
+}
+
+// addInlineTable adds a table arg to the last step, which takes an extra param.
+func (p *parser) addInlineTable(lineNo int, headers []string) {
+	table := p.newTable(lineNo, headers)
+	p.lastStep.Value = fmt.Sprintf("%s %s", p.lastStep.Value, paramPlaceholder)
+	p.lastStep.Args = append(p.lastStep.Args, gauge.StepArg{Type: gauge.TableArg, Table: &table})
+	p.table, p.inlineTable = &table, true
+}
+
+func (p *parser) addTableRow(values []string) {
+	if isSeparator(values) {
+		return
+	}
+	row := make([]gauge.Cell, len(p.table.Headers))
+	for i := range row {
+		if i >= len(values) {
+			continue
+		}
+		row[i] = gauge.Cell{Value: values[i]}
+		if name := strings.TrimSuffix(strings.TrimPrefix(values[i], "<"), ">"); p.inlineTable && len(name)+2 == len(values[i]) && p.lookup(name) {
+			row[i] = gauge.Cell{Value: name, Type: gauge.Dynamic}
+		}
+	}
+	p.table.Rows = append(p.table.Rows, row)
+}
+
+func isStepItem(typ itemType) bool {
+	return typ == itemText || typ == itemStaticParam || typ == itemDynamicParam || typ == itemSpecialParam
+}
+
+// isSeparator reports whether a row only underlines the table header, e.g. |---|---|.
+func isSeparator(values []string) bool {
+	if len(values) == 0 {
+		return false
+	}
+	for _, value := range values {
+		if len(value) > 0 && !isLineOf(value, '-') {
+			return false
+		}
+	}
+	return true
+}
+
+func splitTags(text string) []string {
+	var tags []string
+	for _, tag := range strings.Split(text, ",") {
+		if tag = strings.TrimSpace(tag); len(tag) > 0 {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// unescape drops the backslash of escaped runes, mapping them through escapes if present.
+func unescape(text string, escapes map[rune]rune) string {
+	var result bytes.Buffer
+	escaped := false
+	for _, r := range text {
+		if escaped {
+			if mapped, ok := escapes[r]; ok {
+				r = mapped
+			}
+			result.WriteRune(r)
+			escaped = false
+		} else if r == '\\' {
+			escaped = true
+		} else {
+			result.WriteRune(r)
+		}
+	}
+	return result.String()
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
 		}
 	}
-	return steps
+	return false
 }
@@ -19,7 +19,9 @@ package parse
 
 import (
 	"reflect"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/getgauge/gauge/gauge"
 )
@@ -34,22 +36,24 @@ var conceptParseTests = []conceptParseTest{
 	{"simple concept", "# This is a concept heading\n* This is the first step\n* This is the second step\n",
 		gauge.Concept{
 			FileName: "simple concept",
-			LineNo:   0,
+			LineNo:   1,
 			Heading:  "This is a concept heading",
+			Value:    "This is a concept heading",
 			Steps: []gauge.Step{
-				{LineNo: 0, ActualText: "This is the first step"},
-				{LineNo: 0, ActualText: "This is the second step"},
+				{LineNo: 2, ActualText: "This is the first step", Value: "This is the first step"},
+				{LineNo: 3, ActualText: "This is the second step", Value: "This is the second step"},
 			},
 		},
 	},
 	{"simple underline concept", "This is a concept heading\n=======================\n* This is the first step\n* This is the second step",
 		gauge.Concept{
 			FileName: "simple underline concept",
-			LineNo:   0,
+			LineNo:   1,
 			Heading:  "This is a concept heading",
+			Value:    "This is a concept heading",
 			Steps: []gauge.Step{
-				{LineNo: 0, ActualText: "This is the first step"},
-				{LineNo: 0, ActualText: "This is the second step"},
+				{LineNo: 3, ActualText: "This is the first step", Value: "This is the first step"},
+				{LineNo: 4, ActualText: "This is the second step", Value: "This is the second step"},
 			},
 		},
 	},
@@ -57,9 +61,172 @@ var conceptParseTests = []conceptParseTest{
 
 func TestConceptParsing(t *testing.T) {
 	for _, test := range conceptParseTests {
-		cpt := ParseConcept(test.name, test.text)
+		cpt, err := ParseConcept(test.name, test.text)
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", test.name, err)
+		}
 		if !reflect.DeepEqual(cpt, test.concept) {
 			t.Errorf("%s: \ngot\n\t%+v\nexpected\n\t%v", test.name, cpt, test.concept)
 		}
 	}
 }
+
+func TestConceptWithParamsAndInlineTable(t *testing.T) {
+	text := "# Create user <name>\n* Open form\n* Fill <name> and \"secret\"\n   |field|value|\n   |-----|-----|\n   |id   |<name>|\n"
+	want := []gauge.Concept{{
+		FileName: "params",
+		LineNo:   1,
+		Heading:  "Create user <name>",
+		Value:    "Create user {}",
+		Params:   []string{"name"},
+		Steps: []gauge.Step{
+			{LineNo: 2, ActualText: "Open form", Value: "Open form"},
+			{LineNo: 3, ActualText: "Fill <name> and \"secret\"", Value: "Fill {} and {} {}", Args: []gauge.StepArg{
+				{Type: gauge.Dynamic, Value: "name"},
+				{Type: gauge.Static, Value: "secret"},
+				{Type: gauge.TableArg, Table: &gauge.Table{LineNo: 4, Headers: []string{"field", "value"}, Rows: [][]gauge.Cell{
+					{{Value: "id"}, {Value: "name", Type: gauge.Dynamic}},
+				}}},
+			}},
+		},
+	}}
+
+	concepts, err := ParseConcepts("params", text)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if !reflect.DeepEqual(concepts, want) {
+		t.Errorf("got\n\t%+v\nexpected\n\t%+v", concepts, want)
+	}
+}
+
+type specParseTest struct {
+	name string
+	text string
+	spec gauge.Spec
+}
+
+var specParseTests = []specParseTest{
+	{"spec with context, tags and scenarios", "Spec heading\n============\ntags: login, smoke\n\n* Open browser\n\nA comment\n## First scenario\ntags: slow\n* Say \"hello\" to <file:names.txt>\n\nSecond scenario\n---------------\n* Step with \\{braces\\}\n",
+		gauge.Spec{
+			FileName: "spec with context, tags and scenarios",
+			Heading:  gauge.Heading{LineNo: 1, Text: "Spec heading"},
+			Tags:     []string{"login", "smoke"},
+			Comments: []gauge.Comment{{LineNo: 7, Text: "A comment"}},
+			Contexts: []gauge.Step{{LineNo: 5, ActualText: "Open browser", Value: "Open browser"}},
+			Scenarios: []gauge.Scenario{
+				{
+					Heading: gauge.Heading{LineNo: 8, Text: "First scenario"},
+					Tags:    []string{"slow"},
+					Steps: []gauge.Step{{LineNo: 10, ActualText: "Say \"hello\" to <file:names.txt>", Value: "Say {} to {}", Args: []gauge.StepArg{
+						{Type: gauge.Static, Value: "hello"},
+						{Type: gauge.Special, Value: "file:names.txt"},
+					}}},
+				},
+				{
+					Heading: gauge.Heading{LineNo: 12, Text: "Second scenario"},
+					Steps:   []gauge.Step{{LineNo: 14, ActualText: "Step with \\{braces\\}", Value: "Step with {braces}"}},
+				},
+			},
+		},
+	},
+	{"spec with data table and teardown", "# Data driven\n|id|name|\n|--|----|\n|1 |foo |\n|2 |\n## Scenario\n* Greet <name>\n___\n* Close browser\n",
+		gauge.Spec{
+			FileName: "spec with data table and teardown",
+			Heading:  gauge.Heading{LineNo: 1, Text: "Data driven"},
+			DataTable: &gauge.DataTable{Table: gauge.Table{LineNo: 2, Headers: []string{"id", "name"}, Rows: [][]gauge.Cell{
+				{{Value: "1"}, {Value: "foo"}},
+				{{Value: "2"}, {Value: ""}},
+			}}},
+			Scenarios: []gauge.Scenario{{
+				Heading: gauge.Heading{LineNo: 6, Text: "Scenario"},
+				Steps:   []gauge.Step{{LineNo: 7, ActualText: "Greet <name>", Value: "Greet {}", Args: []gauge.StepArg{{Type: gauge.Dynamic, Value: "name"}}}},
+			}},
+			TearDownSteps: []gauge.Step{{LineNo: 9, ActualText: "Close browser", Value: "Close browser"}},
+		},
+	},
+	{"spec with external data table", "# External\ntable: users.csv\n## Scenario\n* Greet <name>\n",
+		gauge.Spec{
+			FileName:  "spec with external data table",
+			Heading:   gauge.Heading{LineNo: 1, Text: "External"},
+			DataTable: &gauge.DataTable{Table: gauge.Table{LineNo: 2}, Source: "users.csv"},
+			Scenarios: []gauge.Scenario{{
+				Heading: gauge.Heading{LineNo: 3, Text: "Scenario"},
+				Steps:   []gauge.Step{{LineNo: 4, ActualText: "Greet <name>", Value: "Greet {}", Args: []gauge.StepArg{{Type: gauge.Dynamic, Value: "name"}}}},
+			}},
+		},
+	},
+}
+
+func TestSpecParsing(t *testing.T) {
+	for _, test := range specParseTests {
+		spec, err := ParseSpec(test.name, test.text)
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(spec, test.spec) {
+			t.Errorf("%s: \ngot\n\t%+v\nexpected\n\t%+v", test.name, spec, test.spec)
+		}
+	}
+}
+
+type parseErrorTest struct {
+	name    string
+	text    string
+	lineNo  int
+	message string
+}
+
+var specParseErrorTests = []parseErrorTest{
+	{"no heading", "A comment\n", 1, "Spec heading not found"},
+	{"multiple headings", "# First\n# Second\n", 2, "Multiple spec headings found in same file"},
+	{"scenario before heading", "## Scenario\n", 1, "Scenario should be defined after the spec heading"},
+	{"duplicate scenario", "# Spec\n## Scenario\n* step\n## scenario\n", 4, "Duplicate scenario definition 'Scenario' found in the same specification"},
+	{"unresolved dynamic param", "# Spec\n## Scenario\n* Greet <name>\n", 3, "Dynamic parameter <name> could not be resolved"},
+	{"unterminated string", "# Spec\n## Scenario\n* Say \"hello\n", 3, "String not terminated"},
+	{"reserved character", "# Spec\n## Scenario\n* Step {x}\n", 3, "'{' is a reserved character and should be escaped"},
+	{"repeated table header", "# Spec\n|a|a|\n|1|2|\n", 2, "Table header cannot have repeated column values"},
+	{"data table without rows", "# Spec\n|a|b|\n## Scenario\n* step\n", 2, "Data table should have at least 1 data row"},
+	{"short teardown", "# Spec\n__\n", 2, "Teardown should have at least three character"},
+}
+
+func TestSpecParseErrors(t *testing.T) {
+	for _, test := range specParseErrorTests {
+		_, err := ParseSpec(test.name, test.text)
+		want := &ParseError{FileName: test.name, LineNo: test.lineNo, Message: test.message}
+		if !reflect.DeepEqual(err, want) {
+			t.Errorf("%s: got error\n\t%v\nexpected\n\t%v", test.name, err, want)
+		}
+	}
+}
+
+func TestConceptHeadingErrorsDoNotLeakLexers(t *testing.T) {
+	before := runtime.NumGoroutine()
+	for _, text := range []string{"# Say \"hello\n* step\n", "# Say \"hello\"\n* step\n", "# Step {x}\n* step\n"} {
+		if _, err := ParseConcepts("heading", text); err == nil {
+			t.Errorf("%q: expected an error", text)
+		}
+	}
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > before && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("%d lexing goroutines left running", after-before)
+	}
+}
+
+func TestRecoverPanicsAgainForOtherValues(t *testing.T) {
+	defer func() {
+		if e := recover(); e != "unexpected" {
+			t.Errorf("got panic %v, expected the original panic", e)
+		}
+	}()
+	p := New("other panic", "# Spec\n")
+	var err error
+	func() {
+		defer p.recover(&err)
+		panic("unexpected")
+	}()
+	t.Errorf("expected a panic, got error %v", err)
+}
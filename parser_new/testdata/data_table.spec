@@ -0,0 +1,26 @@
+Data driven registration
+========================
+
+     |name |country|
+     |-----|-------|
+     |Ann  |India  |
+     |Bob  |Spain  |
+
+* Open the registration page
+
+Register a user
+---------------
+* Register <name> from <country>
+* Verify the address book
+     |name  |country  |verified|
+     |<name>|<country>|yes     |
+     |<city>|<country>|        |
+
+Register without a country
+--------------------------
+tags: negative
+* Register <name> without a country
+
+___
+* Delete user <name>
+* Close the registration page
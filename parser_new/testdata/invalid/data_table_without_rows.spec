@@ -0,0 +1,5 @@
+# Spec
+|a|b|
+
+## Scenario
+* step
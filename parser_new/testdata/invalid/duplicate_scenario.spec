@@ -0,0 +1,5 @@
+# Spec
+## Scenario
+* step
+## scenario
+* step
@@ -0,0 +1,3 @@
+# Spec
+|a|a|
+|1|2|
@@ -0,0 +1,3 @@
+# Spec
+## Scenario
+* Say "hello
@@ -0,0 +1,23 @@
+# Customer login
+
+This spec covers logging in from the home page.
+
+tags: login, smoke
+
+* Open the application "http://localhost:8080"
+* Clear \"cookies\" and cache
+
+## Login with valid credentials
+tags: happy path
+* Enter username "admin" and password "secret"
+* Verify the welcome message contains "Hello admin"
+
+A comment inside the scenario
+
+## Login with an invalid password
+* Enter username "admin" and password "wrong"
+* Verify that an error "Invalid credentials" is shown
+   |field   |message          |
+   |--------|-----------------|
+   |password|Invalid \| wrong |
+   |username|                 |
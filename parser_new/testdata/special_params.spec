@@ -0,0 +1,8 @@
+# Bulk import
+
+table: users.csv
+
+## Import users from a file
+* Import the users in <table:users.csv>
+* Send the welcome note <file:notes.txt> to <name>
+* Check <unknown:param> is treated as dynamic
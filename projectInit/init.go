@@ -23,6 +23,7 @@ const (
 	skelFileName      = "hello_world.spec"
 	envDefaultDirName = "default"
 	metadataFileName  = "metadata.json"
+	gitIgnoreFileName = ".gitignore"
 )
 
 var defaultPlugins = []string{"html-report"}
@@ -75,6 +76,7 @@ func initializeTemplate(templateName string) error {
 	}
 
 	util.Remove(metadataFile)
+	createGitIgnore()
 	return nil
 }
 
@@ -182,9 +184,65 @@ func createProjectTemplate(language string) error {
 		showMessage("error", fmt.Sprintf("Failed to create %s. %s", defaultJSONDest, err.Error()))
 	}
 
+	createGitIgnore()
 	return runner.ExecuteInitHookForRunner(language)
 }
 
+// createGitIgnore makes the project ignore the files gauge keeps in .gauge/, adding the entries of the skeleton
+// .gitignore to the one a template may have brought.
+func createGitIgnore() {
+	skelGitIgnore, err := common.GetSkeletonFilePath(gitIgnoreFileName)
+	if err != nil {
+		showMessage("error", fmt.Sprintf("Failed to create %s. %s", gitIgnoreFileName, err.Error()))
+		return
+	}
+	gitIgnore := filepath.Join(config.ProjectRoot, gitIgnoreFileName)
+	showMessage("create", gitIgnoreFileName)
+	if !common.FileExists(gitIgnore) {
+		if err := common.CopyFile(skelGitIgnore, gitIgnore); err != nil {
+			showMessage("error", fmt.Sprintf("Failed to create %s. %s", gitIgnoreFileName, err.Error()))
+		}
+		return
+	}
+	skelContents, err := common.ReadFileContents(skelGitIgnore)
+	if err != nil {
+		showMessage("error", fmt.Sprintf("Failed to update %s. %s", gitIgnoreFileName, err.Error()))
+		return
+	}
+	contents, err := common.ReadFileContents(gitIgnore)
+	if err != nil {
+		showMessage("error", fmt.Sprintf("Failed to update %s. %s", gitIgnoreFileName, err.Error()))
+		return
+	}
+	missingEntries := missingGitIgnoreEntries(contents, skelContents)
+	if len(missingEntries) == 0 {
+		showMessage("skip", gitIgnoreFileName)
+		return
+	}
+	if contents != "" && !strings.HasSuffix(contents, "\n") {
+		contents += "\n"
+	}
+	contents += strings.Join(missingEntries, "\n") + "\n"
+	if err := common.SaveFile(gitIgnore, contents, false); err != nil {
+		showMessage("error", fmt.Sprintf("Failed to update %s. %s", gitIgnoreFileName, err.Error()))
+	}
+}
+
+func missingGitIgnoreEntries(contents, skelContents string) []string {
+	entries := make(map[string]bool)
+	for _, line := range strings.Split(contents, "\n") {
+		entries[strings.TrimSpace(line)] = true
+	}
+	missing := make([]string, 0)
+	for _, line := range strings.Split(skelContents, "\n") {
+		entry := strings.TrimSpace(line)
+		if entry != "" && !entries[entry] {
+			missing = append(missing, entry)
+		}
+	}
+	return missing
+}
+
 // SetWorkingDir sets the current working directory to specified location
 func SetWorkingDir(workingDir string) {
 	targetDir, err := filepath.Abs(workingDir)
@@ -0,0 +1,134 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/runner"
+)
+
+const stepRenameSeparator = "=>"
+
+// stepRename is a line of a batch refactoring file, renaming the old step text to the new one.
+type stepRename struct {
+	lineNo  int
+	oldStep string
+	newStep string
+}
+
+// PerformBatchRephraseRefactoring rephrases every step listed as "old step => new step" in the given file,
+// with a single parse of the project and a single runner session.
+func PerformBatchRephraseRefactoring(fileName string, startChan *runner.StartChannels) *refactoringResult {
+	return rephraseFromFile(fileName, startChan, false)
+}
+
+// PreviewBatchRephraseRefactoring finds the changes the batch refactoring would make, without saving any of them.
+func PreviewBatchRephraseRefactoring(fileName string, startChan *runner.StartChannels) *refactoringResult {
+	return rephraseFromFile(fileName, startChan, true)
+}
+
+func rephraseFromFile(fileName string, startChan *runner.StartChannels, preview bool) *refactoringResult {
+	defer killRunner(startChan)
+	contents, err := common.ReadFileContents(fileName)
+	if err != nil {
+		return rephraseFailure(fmt.Sprintf("Failed to read %s. %s", fileName, err))
+	}
+	renames, errs := parseStepRenames(fileName, contents)
+	if len(errs) > 0 {
+		return rephraseFailure(errs...)
+	}
+	agents, errs := refactorAgentsFor(fileName, renames, startChan)
+	if len(errs) > 0 {
+		return rephraseFailure(errs...)
+	}
+	if len(agents) == 0 {
+		return &refactoringResult{Success: true, preview: preview}
+	}
+	for _, agent := range agents {
+		agent.preview = preview
+	}
+	return rephraseInProject(agents, startChan, preview)
+}
+
+// parseStepRenames reads lines of "old step => new step". Blank lines and lines starting with # or // are skipped.
+func parseStepRenames(fileName, contents string) ([]*stepRename, []string) {
+	renames := make([]*stepRename, 0)
+	errs := make([]string, 0)
+	for i, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+		steps := strings.Split(line, stepRenameSeparator)
+		if len(steps) != 2 || strings.TrimSpace(steps[0]) == "" || strings.TrimSpace(steps[1]) == "" {
+			errs = append(errs, fmt.Sprintf("%s:%d: Expected a line of the form \"old step %s new step\"", fileName, i+1, stepRenameSeparator))
+			continue
+		}
+		renames = append(renames, &stepRename{lineNo: i + 1, oldStep: strings.TrimSpace(steps[0]), newStep: strings.TrimSpace(steps[1])})
+	}
+	return renames, errs
+}
+
+// refactorAgentsFor creates an agent for each rename which changes a step. Renames of the same step, renames
+// of different steps to the same step and chained renames are reported, since the result would depend on their order.
+func refactorAgentsFor(fileName string, renames []*stepRename, startChan *runner.StartChannels) ([]*rephraseRefactorer, []string) {
+	agents := make([]*rephraseRefactorer, 0)
+	agentRenames := make([]*stepRename, 0)
+	errs := make([]string, 0)
+	for _, rename := range renames {
+		if rename.oldStep == rename.newStep {
+			continue
+		}
+		agent, err := getRefactorAgent(rename.oldStep, rename.newStep, startChan)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s:%d: %s", fileName, rename.lineNo, err))
+			continue
+		}
+		agents = append(agents, agent)
+		agentRenames = append(agentRenames, rename)
+	}
+	for i, agent := range agents {
+		rename := agentRenames[i]
+		for j, other := range agents[:i] {
+			if agent.oldStep.Value == other.oldStep.Value {
+				errs = append(errs, fmt.Sprintf("%s:%d: Step \"%s\" is already renamed at line %d", fileName, rename.lineNo, rename.oldStep, agentRenames[j].lineNo))
+			} else if agent.newStep.Value == other.newStep.Value {
+				errs = append(errs, fmt.Sprintf("%s:%d: Step \"%s\" is already the new name of the step renamed at line %d", fileName, rename.lineNo, rename.newStep, agentRenames[j].lineNo))
+			}
+		}
+		for j, other := range agents {
+			if i != j && agent.newStep.Value == other.oldStep.Value {
+				errs = append(errs, fmt.Sprintf("%s:%d: Step \"%s\" is renamed again at line %d. Rename it to the final step instead", fileName, rename.lineNo, rename.newStep, agentRenames[j].lineNo))
+			}
+		}
+	}
+	return agents, errs
+}
+
+// RefactorStepsFromFile rephrases the steps listed in the given file and prints one summary for all of them.
+func RefactorStepsFromFile(fileName string, startChan *runner.StartChannels) {
+	printRefactoringSummary(PerformBatchRephraseRefactoring(fileName, startChan))
+}
+
+// PreviewRefactorStepsFromFile prints the changes the batch refactoring would make as diffs, without changing any file.
+func PreviewRefactorStepsFromFile(fileName string, startChan *runner.StartChannels) {
+	printPreview(PreviewBatchRephraseRefactoring(fileName, startChan))
+}
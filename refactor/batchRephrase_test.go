@@ -0,0 +1,103 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestParseStepRenamesSkipsCommentsAndReportsMalformedLines(c *C) {
+	contents := "# renames for the login specs\n\nlog in as <user> => sign in as <user>\n// old wording\nopen page=>visit page\nno separator here\n a => b => c\n"
+
+	renames, errs := parseStepRenames("renames.txt", contents)
+
+	c.Assert(len(renames), Equals, 2)
+	c.Assert(*renames[0], DeepEquals, stepRename{lineNo: 3, oldStep: "log in as <user>", newStep: "sign in as <user>"})
+	c.Assert(*renames[1], DeepEquals, stepRename{lineNo: 5, oldStep: "open page", newStep: "visit page"})
+	c.Assert(errs, DeepEquals, []string{
+		"renames.txt:6: Expected a line of the form \"old step => new step\"",
+		"renames.txt:7: Expected a line of the form \"old step => new step\"",
+	})
+}
+
+func (s *MySuite) TestRefactorAgentsForReportsConflictingAndChainedRenames(c *C) {
+	renames := []*stepRename{
+		&stepRename{lineNo: 1, oldStep: "open page", newStep: "visit page"},
+		&stepRename{lineNo: 2, oldStep: "open <page>", newStep: "go to <page>"},
+		&stepRename{lineNo: 3, oldStep: "close page", newStep: "visit page"},
+		&stepRename{lineNo: 4, oldStep: "visit page", newStep: "browse page"},
+		&stepRename{lineNo: 5, oldStep: "pay <amount> to <user>", newStep: "pay <user> the <amount>"},
+		&stepRename{lineNo: 6, oldStep: "same step", newStep: "same step"},
+	}
+
+	agents, errs := refactorAgentsFor("renames.txt", renames, nil)
+
+	c.Assert(len(agents), Equals, 5)
+	c.Assert(errs, DeepEquals, []string{
+		"renames.txt:1: Step \"visit page\" is renamed again at line 4. Rename it to the final step instead",
+		"renames.txt:3: Step \"visit page\" is already the new name of the step renamed at line 1",
+		"renames.txt:3: Step \"visit page\" is renamed again at line 4. Rename it to the final step instead",
+	})
+}
+
+func (s *MySuite) TestRefactorAgentsForReportsStepsRenamedTwice(c *C) {
+	renames := []*stepRename{
+		&stepRename{lineNo: 1, oldStep: "log in as <user>", newStep: "sign in as <user>"},
+		&stepRename{lineNo: 2, oldStep: "log in as <name>", newStep: "enter as <name>"},
+	}
+
+	_, errs := refactorAgentsFor("renames.txt", renames, nil)
+
+	c.Assert(errs, DeepEquals, []string{"renames.txt:2: Step \"log in as <name>\" is already renamed at line 1"})
+}
+
+func (s *MySuite) TestPerformRefactoringsPreviewsAllRenamesTogether(c *C) {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	conceptFile := filepath.Join(dir, "login.cpt")
+	conceptText := "# log in as <user>\n* enter <user>\n\n# log out\n* click logout\n"
+	c.Assert(ioutil.WriteFile(conceptFile, []byte(conceptText), 0644), IsNil)
+	dictionary := parser.NewConceptDictionary()
+	c.Assert(parser.AddConcepts(conceptFile, dictionary), IsNil)
+	renames := []*stepRename{
+		&stepRename{lineNo: 1, oldStep: "log in as <user>", newStep: "sign in as <user>"},
+		&stepRename{lineNo: 2, oldStep: "log out", newStep: "sign out"},
+	}
+	agents, errs := refactorAgentsFor("renames.txt", renames, nil)
+	c.Assert(errs, DeepEquals, []string{})
+	for _, agent := range agents {
+		agent.preview = true
+	}
+
+	result := performRefactorings(agents, make([]*parser.Specification, 0), dictionary, nil, true)
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(result.conceptsChanged, DeepEquals, []string{conceptFile})
+	contents, _ := common.ReadFileContents(conceptFile)
+	c.Assert(contents, Equals, conceptText)
+	changes := result.FileChanges()
+	c.Assert(len(changes), Equals, 1)
+	c.Assert(changes[0].GetFileContent(), Equals, "# sign in as <user>\n* enter <user>\n\n# sign out\n* click logout\n")
+}
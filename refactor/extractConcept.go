@@ -0,0 +1,354 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+const defaultConceptFileName = "concepts.cpt"
+
+// stepSequence is a run of steps written one after the other in a spec or concept, or a part of one.
+type stepSequence struct {
+	fileName string
+	steps    []*parser.Step
+}
+
+// extractedParam is an argument of the extracted steps which becomes a parameter of the concept.
+type extractedParam struct {
+	name      string
+	stepIndex int
+	argIndex  int
+}
+
+// ExtractConceptFromLines extracts the steps written in the given lines of a spec into a concept, and replaces
+// every occurrence of the same steps in the project with the concept. Arguments which differ between the
+// occurrences, and references to data table columns, become parameters of the concept.
+func ExtractConceptFromLines(specFile string, startLine, endLine int, conceptName, conceptFile string, preview bool) *refactoringResult {
+	result := &refactoringResult{Success: true, Errors: make([]string, 0), warnings: make([]string, 0), preview: preview}
+	if conceptFile == "" {
+		conceptFile = filepath.Join(config.ProjectRoot, common.SpecsDirectoryName, defaultConceptFileName)
+	}
+	if !util.IsConcept(conceptFile) {
+		return rephraseFailure(fmt.Sprintf("Invalid concept file '%s'", conceptFile))
+	}
+	conceptDictionary, parseResult := parser.CreateConceptsDictionary(false)
+	addErrorsAndWarningsToRefactoringResult(result, parseResult)
+	if !result.Success {
+		return result
+	}
+	specs, specParseResults := parser.FindSpecs(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName), conceptDictionary)
+	addErrorsAndWarningsToRefactoringResult(result, specParseResults...)
+	if !result.Success {
+		return result
+	}
+	sequences := stepSequences(specs, conceptDictionary)
+	selected, err := selectedSteps(sequences, specFile, startLine, endLine)
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	occurrences := occurrencesOf(selected, sequences)
+	params := extractedParams(selected, occurrences)
+	heading, err := conceptHeading(conceptName, params)
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	if conceptDictionary.Search(heading.Value) != nil {
+		return rephraseFailure(fmt.Sprintf("Concept '%s' already exists", conceptName))
+	}
+	edits := make(map[string][]*linesEdit)
+	for _, occurrence := range occurrences {
+		usage := strings.Split(strings.TrimSuffix(formatter.FormatStep(conceptUsageStep(heading, params, occurrence)), "\n"), "\n")
+		edit := &linesEdit{start: occurrence.steps[0].Span.StartLine, step: occurrence.steps[len(occurrence.steps)-1], lines: usage}
+		edits[occurrence.fileName] = append(edits[occurrence.fileName], edit)
+	}
+	conceptFile, err = filepath.Abs(conceptFile)
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	if _, ok := edits[conceptFile]; !ok {
+		edits[conceptFile] = []*linesEdit{}
+	}
+	for fileName, fileEdits := range edits {
+		source := ""
+		if common.FileExists(fileName) {
+			if source, err = common.ReadFileContents(fileName); err != nil {
+				return rephraseFailure(err.Error())
+			}
+		}
+		content := applyLinesEdits(source, fileEdits)
+		if fileName == conceptFile {
+			content = withBlocks(content, []*conceptBlock{&conceptBlock{lines: conceptDefinition(heading, params, selected)}})
+		}
+		result.fileChanges = append(result.fileChanges, &fileChange{fileName: fileName, content: content})
+	}
+	sort.Sort(byFileName(result.fileChanges))
+	for _, change := range result.fileChanges {
+		if util.IsConcept(change.fileName) {
+			result.conceptsChanged = append(result.conceptsChanged, change.fileName)
+		} else {
+			result.specsChanged = append(result.specsChanged, change.fileName)
+		}
+	}
+	if !preview {
+		if err := result.save(); err != nil {
+			result.Success = false
+			result.Errors = append(result.Errors, fmt.Sprintf("Cannot perform refactoring: %s", err))
+		}
+	}
+	return result
+}
+
+// stepSequences finds the runs of steps not separated by comments or tables, in specs and in concept definitions.
+func stepSequences(specs []*parser.Specification, conceptDictionary *parser.ConceptDictionary) []*stepSequence {
+	sequences := make([]*stepSequence, 0)
+	for _, spec := range specs {
+		sequences = append(sequences, sequencesIn(spec.FileName, spec.Items, nil)...)
+		for _, scenario := range spec.Scenarios {
+			sequences = append(sequences, sequencesIn(spec.FileName, scenario.Items, nil)...)
+		}
+	}
+	for _, concept := range conceptDictionary.ConceptsMap {
+		sequences = append(sequences, sequencesIn(concept.FileName, concept.ConceptStep.Items, concept.ConceptStep)...)
+	}
+	return sequences
+}
+
+func sequencesIn(fileName string, items []parser.Item, conceptHeading *parser.Step) []*stepSequence {
+	sequences := make([]*stepSequence, 0)
+	current := &stepSequence{fileName: fileName}
+	for _, item := range items {
+		step, isStep := item.(*parser.Step)
+		// steps of included files belong to those files
+		if isStep && step != conceptHeading && step.FileName == "" && step.Span.StartLine > 0 {
+			current.steps = append(current.steps, step)
+			continue
+		}
+		if len(current.steps) > 0 {
+			sequences = append(sequences, current)
+		}
+		current = &stepSequence{fileName: fileName}
+	}
+	if len(current.steps) > 0 {
+		sequences = append(sequences, current)
+	}
+	return sequences
+}
+
+// selectedSteps finds the steps written in the lines, which should all be in the same run of steps.
+func selectedSteps(sequences []*stepSequence, specFile string, startLine, endLine int) (*stepSequence, error) {
+	var selected *stepSequence
+	for _, sequence := range sequences {
+		if !sameFile(sequence.fileName, specFile) {
+			continue
+		}
+		steps := make([]*parser.Step, 0)
+		for _, step := range sequence.steps {
+			if step.Span.StartLine >= startLine && step.Span.StartLine <= endLine {
+				steps = append(steps, step)
+			}
+		}
+		if len(steps) == 0 {
+			continue
+		}
+		if selected != nil {
+			return nil, fmt.Errorf("The lines %d-%d of %s have steps separated by other items", startLine, endLine, specFile)
+		}
+		selected = &stepSequence{fileName: sequence.fileName, steps: steps}
+	}
+	if selected == nil {
+		return nil, fmt.Errorf("No steps found in the lines %d-%d of %s", startLine, endLine, specFile)
+	}
+	return selected, nil
+}
+
+// occurrencesOf finds the selected steps and every other occurrence of the same steps, which do not overlap.
+func occurrencesOf(selected *stepSequence, sequences []*stepSequence) []*stepSequence {
+	occurrences := []*stepSequence{selected}
+	length := len(selected.steps)
+	for _, sequence := range sequences {
+		for i := 0; i+length <= len(sequence.steps); i++ {
+			candidate := sequence.steps[i : i+length]
+			if candidate[0] == selected.steps[0] || !sameSteps(candidate, selected.steps) || overlaps(candidate, selected.steps) {
+				continue
+			}
+			occurrences = append(occurrences, &stepSequence{fileName: sequence.fileName, steps: candidate})
+			i += length - 1
+		}
+	}
+	return occurrences
+}
+
+func overlaps(steps1, steps2 []*parser.Step) bool {
+	for _, step1 := range steps1 {
+		for _, step2 := range steps2 {
+			if step1 == step2 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sameSteps compares the steps leaving out the arguments which can be passed to a concept.
+func sameSteps(steps1, steps2 []*parser.Step) bool {
+	for i := range steps1 {
+		if steps1[i].Value != steps2[i].Value || len(steps1[i].Args) != len(steps2[i].Args) {
+			return false
+		}
+		for j, arg := range steps1[i].Args {
+			other := steps2[i].Args[j]
+			if isPassable(arg) != isPassable(other) {
+				return false
+			}
+			if !isPassable(arg) && formatter.FormatStep(&parser.Step{Value: parser.ParameterPlaceholder, Args: []*parser.StepArg{arg}}) != formatter.FormatStep(&parser.Step{Value: parser.ParameterPlaceholder, Args: []*parser.StepArg{other}}) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isPassable tells whether the argument can be given as a parameter of a concept. Tables and text blocks
+// are kept in the concept as written.
+func isPassable(arg *parser.StepArg) bool {
+	return arg.ArgType != parser.TableArg && arg.ArgType != parser.TextBlock
+}
+
+func argText(arg *parser.StepArg) string {
+	if arg.ArgType == parser.SpecialString || arg.ArgType == parser.SpecialTable {
+		return string(arg.ArgType) + arg.Name
+	}
+	return string(arg.ArgType) + arg.Value
+}
+
+// extractedParams finds the arguments which become parameters: the ones that differ between the occurrences and
+// the references to data table columns or concept parameters, which are named after them.
+func extractedParams(selected *stepSequence, occurrences []*stepSequence) []*extractedParam {
+	params := make([]*extractedParam, 0)
+	names := make(map[string]bool)
+	for i, step := range selected.steps {
+		for j, arg := range step.Args {
+			if !isPassable(arg) {
+				continue
+			}
+			differs := false
+			for _, occurrence := range occurrences {
+				if argText(occurrence.steps[i].Args[j]) != argText(arg) {
+					differs = true
+				}
+			}
+			if !differs && arg.ArgType != parser.Dynamic {
+				continue
+			}
+			name := fmt.Sprintf("arg%d", len(params)+1)
+			if !differs {
+				name = arg.Value
+			}
+			params = append(params, &extractedParam{name: uniqueName(name, names), stepIndex: i, argIndex: j})
+		}
+	}
+	return params
+}
+
+func uniqueName(name string, names map[string]bool) string {
+	unique := name
+	for i := 2; names[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	names[unique] = true
+	return unique
+}
+
+// conceptHeading names the parameters after the placeholders of the concept name, in order. Parameters left
+// unnamed are added at the end of the heading.
+func conceptHeading(conceptName string, params []*extractedParam) (*parser.Step, error) {
+	tokens, err := new(parser.SpecParser).GenerateTokens("* " + conceptName)
+	if err != nil {
+		return nil, err
+	}
+	heading, parseDetails := (&parser.Specification{}).CreateStepUsingLookup(tokens[0], nil)
+	if parseDetails != nil && parseDetails.Error != nil {
+		return nil, parseDetails.Error
+	}
+	if len(heading.Args) > len(params) {
+		return nil, fmt.Errorf("Concept name '%s' has %d parameters, but the steps have %d arguments to extract", conceptName, len(heading.Args), len(params))
+	}
+	for i, arg := range heading.Args {
+		if arg.ArgType != parser.Dynamic {
+			return nil, fmt.Errorf("Concept name '%s' should have only <parameters>", conceptName)
+		}
+		params[i].name = arg.Value
+	}
+	for _, param := range params[len(heading.Args):] {
+		heading.Value += " " + parser.ParameterPlaceholder
+		heading.Args = append(heading.Args, &parser.StepArg{Value: param.name, ArgType: parser.Dynamic})
+	}
+	return heading, nil
+}
+
+func conceptDefinition(heading *parser.Step, params []*extractedParam, selected *stepSequence) []string {
+	definition := "# " + strings.TrimPrefix(formatter.FormatStep(heading), "* ")
+	for i, step := range selected.steps {
+		body := &parser.Step{Value: step.Value, Args: append([]*parser.StepArg{}, step.Args...)}
+		for _, param := range params {
+			if param.stepIndex == i {
+				body.Args[param.argIndex] = &parser.StepArg{Value: param.name, ArgType: parser.Dynamic}
+			}
+		}
+		definition += formatter.FormatStep(body)
+	}
+	return strings.Split(strings.TrimSuffix(definition, "\n"), "\n")
+}
+
+func conceptUsageStep(heading *parser.Step, params []*extractedParam, occurrence *stepSequence) *parser.Step {
+	usage := &parser.Step{Value: heading.Value}
+	for _, param := range params {
+		usage.Args = append(usage.Args, occurrence.steps[param.stepIndex].Args[param.argIndex])
+	}
+	return usage
+}
+
+func extractConcept(specFile, lineRange, conceptName, conceptFile string, preview bool) *refactoringResult {
+	startLine, endLine, err := formatter.ParseLineRange(lineRange)
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	if startLine == 0 {
+		return rephraseFailure("The lines of the steps to extract should be given. Eg: --extract-concept-lines 10-12")
+	}
+	return ExtractConceptFromLines(specFile, startLine, endLine, conceptName, conceptFile, preview)
+}
+
+func RefactorExtractConcept(specFile, lineRange, conceptName, conceptFile string) {
+	printRefactoringSummary(extractConcept(specFile, lineRange, conceptName, conceptFile, false))
+}
+
+// PreviewExtractConcept prints the changes extracting a concept would make as diffs, without changing any file.
+func PreviewExtractConcept(specFile, lineRange, conceptName, conceptFile string) {
+	printPreview(extractConcept(specFile, lineRange, conceptName, conceptFile, true))
+}
@@ -0,0 +1,87 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestExtractConceptParameterizesDifferingArgumentsAndReplacesOccurrences(c *C) {
+	checkout := "Checkout\n========\n\n|user|\n|----|\n|jane|\n\n## Pay by card\n* open \"login\" page\n* enter <user> and \"secret\"\n// pay now\n* pay\n\n## Pay later\n* open \"home\" page\n* open \"login\" page\n* enter <user> and \"pass\"\n* check basket\n"
+	account := "Account\n=======\n\n## Delete\n* open \"login\" page\n* enter \"john\" and \"secret\"\n"
+	specsDir, cleanup := createProject(c, map[string]string{
+		"checkout.spec": checkout,
+		"account.spec":  account,
+		"steps.cpt":     "# sign in again\n* open \"login\" page\n* enter \"admin\" and \"admin\"\n",
+	})
+	defer cleanup()
+	checkoutFile := filepath.Join(specsDir, "checkout.spec")
+
+	result := ExtractConceptFromLines(checkoutFile, 9, 10, "log in as <name>", "", false)
+
+	c.Assert(result.Errors, DeepEquals, []string{})
+	c.Assert(result.specsChanged, DeepEquals, []string{filepath.Join(specsDir, "account.spec"), checkoutFile})
+	c.Assert(result.conceptsChanged, DeepEquals, []string{filepath.Join(specsDir, "concepts.cpt"), filepath.Join(specsDir, "steps.cpt")})
+	contents, _ := common.ReadFileContents(filepath.Join(specsDir, "concepts.cpt"))
+	c.Assert(contents, Equals, "# log in as <name> <arg2>\n* open \"login\" page\n* enter <name> and <arg2>\n")
+	contents, _ = common.ReadFileContents(checkoutFile)
+	c.Assert(contents, Equals, "Checkout\n========\n\n|user|\n|----|\n|jane|\n\n## Pay by card\n* log in as <user> \"secret\"\n// pay now\n* pay\n\n## Pay later\n* open \"home\" page\n* log in as <user> \"pass\"\n* check basket\n")
+	contents, _ = common.ReadFileContents(filepath.Join(specsDir, "account.spec"))
+	c.Assert(contents, Equals, "Account\n=======\n\n## Delete\n* log in as \"john\" \"secret\"\n")
+	contents, _ = common.ReadFileContents(filepath.Join(specsDir, "steps.cpt"))
+	c.Assert(contents, Equals, "# sign in again\n* log in as \"admin\" \"admin\"\n")
+}
+
+func (s *MySuite) TestExtractConceptKeepsArgumentsCommonToAllOccurrences(c *C) {
+	specText := "Spec\n====\n\n## One\n* open \"login\" page\n* click \"submit\"\n\n## Two\n* open \"login\" page\n* click \"cancel\"\n"
+	specsDir, cleanup := createProject(c, map[string]string{"example.spec": specText})
+	defer cleanup()
+	conceptFile := filepath.Join(specsDir, "login.cpt")
+
+	result := ExtractConceptFromLines(filepath.Join(specsDir, "example.spec"), 5, 6, "submit login with <button>", conceptFile, true)
+
+	c.Assert(result.Errors, DeepEquals, []string{})
+	c.Assert(len(result.fileChanges), Equals, 2)
+	c.Assert(result.fileChanges[0].content, Equals, "Spec\n====\n\n## One\n* submit login with \"submit\"\n\n## Two\n* submit login with \"cancel\"\n")
+	c.Assert(result.fileChanges[1].fileName, Equals, conceptFile)
+	c.Assert(result.fileChanges[1].content, Equals, "# submit login with <button>\n* open \"login\" page\n* click <button>\n")
+	c.Assert(common.FileExists(conceptFile), Equals, false)
+}
+
+func (s *MySuite) TestExtractConceptFailsForStepsSeparatedByComments(c *C) {
+	specsDir, cleanup := createProject(c, map[string]string{"example.spec": "Spec\n====\n\n## One\n* step one\nA comment\n* step two\n"})
+	defer cleanup()
+
+	result := ExtractConceptFromLines(filepath.Join(specsDir, "example.spec"), 5, 7, "both steps", "", true)
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(result.Errors, DeepEquals, []string{"The lines 5-7 of " + filepath.Join(specsDir, "example.spec") + " have steps separated by other items"})
+}
+
+func (s *MySuite) TestExtractConceptFailsForTooManyParametersInName(c *C) {
+	specsDir, cleanup := createProject(c, map[string]string{"example.spec": "Spec\n====\n\n## One\n* step one\n"})
+	defer cleanup()
+
+	result := ExtractConceptFromLines(filepath.Join(specsDir, "example.spec"), 5, 5, "do <it>", "", true)
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(result.Errors, DeepEquals, []string{"Concept name 'do <it>' has 1 parameters, but the steps have 0 arguments to extract"})
+}
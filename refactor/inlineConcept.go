@@ -0,0 +1,353 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+// conceptUsage is a step calling the concept being inlined, in a spec, a context file or another concept.
+type conceptUsage struct {
+	fileName  string
+	step      *parser.Step
+	isConcept bool
+}
+
+// linesEdit replaces the lines from start to end, both 1 based and inclusive. The end of an edit replacing
+// a step is found from the step, and 0 stands for the end of the file.
+type linesEdit struct {
+	start int
+	end   int
+	step  *parser.Step
+	lines []string
+}
+
+type byStartLine []*linesEdit
+
+func (s byStartLine) Len() int           { return len(s) }
+func (s byStartLine) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byStartLine) Less(i, j int) bool { return s[i].start > s[j].start }
+
+// InlineConcept replaces the usages of a concept by its steps, substituting the arguments of each usage for the
+// parameters of the concept. Only the usage starting at the given line is replaced when a file name is given.
+// The concept definition is removed if deleteConcept is set and nothing else uses it.
+func InlineConcept(conceptText, fileName string, lineNo int, deleteConcept, preview bool) *refactoringResult {
+	result := &refactoringResult{Success: true, Errors: make([]string, 0), warnings: make([]string, 0), preview: preview}
+	conceptValue, err := stepValueOf(conceptText)
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	conceptDictionary, parseResult := parser.CreateConceptsDictionary(false)
+	addErrorsAndWarningsToRefactoringResult(result, parseResult)
+	if !result.Success {
+		return result
+	}
+	specs, specParseResults := parser.FindSpecs(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName), conceptDictionary)
+	addErrorsAndWarningsToRefactoringResult(result, specParseResults...)
+	if !result.Success {
+		return result
+	}
+	concept := conceptDictionary.Search(conceptValue)
+	if concept == nil {
+		return rephraseFailure(fmt.Sprintf("Concept '%s' not found", conceptText))
+	}
+	usages := conceptUsages(concept, specs, conceptDictionary)
+	inlined := usages
+	if fileName != "" {
+		inlined = usagesAt(usages, fileName, lineNo)
+		if len(inlined) == 0 {
+			return rephraseFailure(fmt.Sprintf("No usage of concept '%s' found at %s:%d", conceptText, fileName, lineNo))
+		}
+	}
+	if len(inlined) == 0 && !deleteConcept {
+		return rephraseFailure(fmt.Sprintf("Concept '%s' is not used", conceptText))
+	}
+	edits := make(map[string][]*linesEdit)
+	for _, usage := range inlined {
+		edits[usage.fileName] = append(edits[usage.fileName], inlinedUsageEdit(usage, concept.ConceptStep))
+	}
+	if deleteConcept {
+		if len(usages) > len(inlined) {
+			result.warnings = append(result.warnings, fmt.Sprintf("Concept '%s' is still used, its definition is kept", conceptText))
+		} else {
+			edits[concept.FileName] = append(edits[concept.FileName], definitionEdit(concept, conceptDictionary))
+		}
+	}
+	for fileName, fileEdits := range edits {
+		source, err := common.ReadFileContents(fileName)
+		if err != nil {
+			return rephraseFailure(err.Error())
+		}
+		change := &fileChange{fileName: fileName, content: applyLinesEdits(source, fileEdits)}
+		result.fileChanges = append(result.fileChanges, change)
+	}
+	sort.Sort(byFileName(result.fileChanges))
+	for _, change := range result.fileChanges {
+		if util.IsConcept(change.fileName) {
+			result.conceptsChanged = append(result.conceptsChanged, change.fileName)
+		} else {
+			result.specsChanged = append(result.specsChanged, change.fileName)
+		}
+	}
+	if !preview {
+		if err := result.save(); err != nil {
+			result.Success = false
+			result.Errors = append(result.Errors, fmt.Sprintf("Cannot perform refactoring: %s", err))
+		}
+	}
+	return result
+}
+
+func stepValueOf(stepText string) (string, error) {
+	tokens, err := new(parser.SpecParser).GenerateTokens("* " + stepText)
+	if err != nil {
+		return "", err
+	}
+	step, parseDetails := (&parser.Specification{}).CreateStepUsingLookup(tokens[0], nil)
+	if parseDetails != nil && parseDetails.Error != nil {
+		return "", parseDetails.Error
+	}
+	return step.Value, nil
+}
+
+func conceptUsages(concept *parser.Concept, specs []*parser.Specification, conceptDictionary *parser.ConceptDictionary) []*conceptUsage {
+	usages := make([]*conceptUsage, 0)
+	calls := func(step *parser.Step) bool {
+		return step.IsConcept && conceptDictionary.Search(step.Value) == concept
+	}
+	contextUsages := make(map[string]bool)
+	for _, spec := range specs {
+		steps := append(append([]*parser.Step{}, spec.Contexts...), spec.TearDownSteps...)
+		for _, scenario := range spec.Scenarios {
+			steps = append(steps, scenario.Steps...)
+		}
+		for _, step := range steps {
+			if !calls(step) {
+				continue
+			}
+			if step.FileName == "" {
+				usages = append(usages, &conceptUsage{fileName: spec.FileName, step: step})
+				continue
+			}
+			// steps of included files belong to those files, which every spec including them parses again
+			key := fmt.Sprintf("%s:%d", step.FileName, step.Span.StartLine)
+			if !contextUsages[key] {
+				contextUsages[key] = true
+				usages = append(usages, &conceptUsage{fileName: step.FileName, step: step})
+			}
+		}
+	}
+	for _, other := range conceptDictionary.ConceptsMap {
+		for _, step := range other.ConceptStep.ConceptSteps {
+			if calls(step) {
+				usages = append(usages, &conceptUsage{fileName: other.FileName, step: step, isConcept: true})
+			}
+		}
+	}
+	return usages
+}
+
+func usagesAt(usages []*conceptUsage, fileName string, lineNo int) []*conceptUsage {
+	selected := make([]*conceptUsage, 0)
+	for _, usage := range usages {
+		if sameFile(usage.fileName, fileName) && usage.step.Span.StartLine == lineNo {
+			selected = append(selected, usage)
+		}
+	}
+	return selected
+}
+
+func sameFile(fileName1, fileName2 string) bool {
+	path1, err1 := filepath.Abs(fileName1)
+	path2, err2 := filepath.Abs(fileName2)
+	return err1 == nil && err2 == nil && path1 == path2
+}
+
+// inlinedUsageEdit replaces the usage, along with its table or text block arguments, by the steps of the concept.
+func inlinedUsageEdit(usage *conceptUsage, concept *parser.Step) *linesEdit {
+	args := conceptArgs(usage.step, concept)
+	lines := make([]string, 0)
+	for _, step := range concept.ConceptSteps {
+		lines = append(lines, strings.Split(strings.TrimSuffix(formatter.FormatStep(inlinedStep(step, args)), "\n"), "\n")...)
+	}
+	return &linesEdit{start: usage.step.Span.StartLine, step: usage.step, lines: lines}
+}
+
+// conceptArgs maps the parameters of the concept to the arguments of a usage. Parameters omitted by the usage
+// take their default values.
+func conceptArgs(usage *parser.Step, concept *parser.Step) map[string]*parser.StepArg {
+	args := make(map[string]*parser.StepArg)
+	defaults := conceptDefaults(concept)
+	for i, param := range concept.Args {
+		if i < len(usage.Args) {
+			args[param.Value] = usage.Args[i]
+		} else if value, ok := defaults[param.Value]; ok {
+			args[param.Value] = &parser.StepArg{Value: value, ArgType: parser.Static}
+		}
+	}
+	return args
+}
+
+func conceptDefaults(concept *parser.Step) map[string]string {
+	defaults := make(map[string]string)
+	for _, item := range concept.Items {
+		if dataTable, ok := item.(*parser.DataTable); ok && dataTable.Table.GetRowCount() > 0 {
+			for _, header := range dataTable.Table.Headers {
+				defaults[header] = dataTable.Table.Get(header)[0].Value
+			}
+		}
+	}
+	return defaults
+}
+
+func inlinedStep(step *parser.Step, args map[string]*parser.StepArg) *parser.Step {
+	inlined := &parser.Step{Value: step.Value, IsConcept: step.IsConcept, HasInlineTable: step.HasInlineTable, HasTextBlock: step.HasTextBlock}
+	for _, arg := range step.Args {
+		inlined.Args = append(inlined.Args, inlinedArg(arg, args))
+	}
+	return inlined
+}
+
+func inlinedArg(arg *parser.StepArg, args map[string]*parser.StepArg) *parser.StepArg {
+	switch arg.ArgType {
+	case parser.Dynamic:
+		if value, ok := args[arg.Value]; ok {
+			return value
+		}
+	case parser.TableArg:
+		return &parser.StepArg{Name: arg.Name, ArgType: parser.TableArg, Table: *inlinedTable(&arg.Table, args)}
+	}
+	return arg
+}
+
+// inlinedTable replaces the cells referring to concept parameters by the static or dynamic arguments of the usage.
+func inlinedTable(table *parser.Table, args map[string]*parser.StepArg) *parser.Table {
+	inlined := &parser.Table{}
+	inlined.AddHeaders(table.Headers)
+	for row := 0; row < table.GetRowCount(); row++ {
+		values := make([]string, 0)
+		for _, header := range table.Headers {
+			cell := table.Get(header)[row]
+			value := cell.GetValue()
+			if arg, ok := args[cell.Value]; ok && cell.CellType == parser.Dynamic {
+				if arg.ArgType == parser.Static {
+					value = arg.Value
+				} else if arg.ArgType == parser.Dynamic {
+					value = fmt.Sprintf("<%s>", arg.Value)
+				}
+			}
+			values = append(values, value)
+		}
+		inlined.AddRowValues(values)
+	}
+	return inlined
+}
+
+// definitionEdit removes the concept definition, up to the next concept in the file.
+func definitionEdit(concept *parser.Concept, conceptDictionary *parser.ConceptDictionary) *linesEdit {
+	start := concept.ConceptStep.Span.StartLine
+	end := 0
+	for _, other := range conceptDictionary.ConceptsMap {
+		otherStart := other.ConceptStep.Span.StartLine
+		if other.FileName == concept.FileName && otherStart > start && (end == 0 || otherStart-1 < end) {
+			end = otherStart - 1
+		}
+	}
+	return &linesEdit{start: start, end: end, lines: []string{}}
+}
+
+func applyLinesEdits(source string, edits []*linesEdit) string {
+	lines := strings.Split(source, "\n")
+	for _, edit := range edits {
+		if edit.step != nil {
+			edit.end = formatter.StepEndLine(lines, edit.step)
+		} else if edit.end == 0 {
+			edit.end = len(lines)
+			for edit.start > 1 && strings.TrimSpace(lines[edit.start-2]) == "" {
+				edit.start--
+			}
+		}
+	}
+	sort.Sort(byStartLine(edits))
+	for _, edit := range edits {
+		edited := editedLines(lines, edit)
+		if edit.end == len(lines) && edit.start > 1 && len(edited) == 0 {
+			edited = []string{""}
+		}
+		lines = append(lines[:edit.start-1], append(edited, lines[edit.end:]...)...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// editedLines keeps the indentation and line ending of the replaced lines.
+func editedLines(lines []string, edit *linesEdit) []string {
+	first := lines[edit.start-1]
+	indent := first[:len(first)-len(strings.TrimLeft(first, " \t"))]
+	lineEnding := ""
+	if strings.HasSuffix(lines[edit.end-1], "\r") {
+		lineEnding = "\r"
+	}
+	edited := make([]string, 0)
+	for _, line := range edit.lines {
+		edited = append(edited, indent+common.TrimTrailingSpace(line)+lineEnding)
+	}
+	return edited
+}
+
+// parseUsage splits a usage given as file:line.
+func parseUsage(usage string) (string, int, error) {
+	if usage == "" {
+		return "", 0, nil
+	}
+	separator := strings.LastIndex(usage, ":")
+	if separator <= 0 {
+		return "", 0, fmt.Errorf("Invalid usage '%s', expected file:line", usage)
+	}
+	lineNo, err := strconv.Atoi(usage[separator+1:])
+	if err != nil || lineNo < 1 {
+		return "", 0, fmt.Errorf("Invalid usage '%s', expected file:line", usage)
+	}
+	return usage[:separator], lineNo, nil
+}
+
+func inlineConcept(conceptText, usage string, deleteConcept, preview bool) *refactoringResult {
+	fileName, lineNo, err := parseUsage(usage)
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	return InlineConcept(conceptText, fileName, lineNo, deleteConcept, preview)
+}
+
+func RefactorInlineConcept(conceptText, usage string, deleteConcept bool) {
+	printRefactoringSummary(inlineConcept(conceptText, usage, deleteConcept, false))
+}
+
+// PreviewInlineConcept prints the changes inlining a concept would make as diffs, without changing any file.
+func PreviewInlineConcept(conceptText, usage string, deleteConcept bool) {
+	printPreview(inlineConcept(conceptText, usage, deleteConcept, true))
+}
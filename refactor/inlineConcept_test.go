@@ -0,0 +1,122 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+)
+
+func createProject(c *C, files map[string]string) (string, func()) {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	projectRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	specsDir := filepath.Join(dir, common.SpecsDirectoryName)
+	c.Assert(os.MkdirAll(specsDir, 0755), IsNil)
+	for name, content := range files {
+		c.Assert(ioutil.WriteFile(filepath.Join(specsDir, name), []byte(content), 0644), IsNil)
+	}
+	return specsDir, func() {
+		config.ProjectRoot = projectRoot
+		os.RemoveAll(dir)
+	}
+}
+
+func (s *MySuite) TestInlineConceptReplacesEveryUsageWithArguments(c *C) {
+	conceptText := "# log in as <user> with <password>\n* open login page\n* enter <user> and <password>\n* check table\n   |name  |secret    |\n   |------|----------|\n   |<user>|<password>|\n\n# sign up\n* log in as \"new\" with \"secret\"\n"
+	specText := "Spec\n====\n\n|id|name|\n|--|----|\n|1 |jane|\n\n## Scenario\n* log in as <name> with \"pass\"\n* other   step\n"
+	specsDir, cleanup := createProject(c, map[string]string{"login.cpt": conceptText, "example.spec": specText})
+	defer cleanup()
+
+	result := InlineConcept("log in as <user> with <password>", "", 0, false, false)
+
+	c.Assert(result.Errors, DeepEquals, []string{})
+	c.Assert(result.Success, Equals, true)
+	contents, _ := common.ReadFileContents(filepath.Join(specsDir, "example.spec"))
+	c.Assert(contents, Equals, "Spec\n====\n\n|id|name|\n|--|----|\n|1 |jane|\n\n## Scenario\n* open login page\n* enter <name> and \"pass\"\n* check table\n     |name  |secret|\n     |------|------|\n     |<name>|pass  |\n* other   step\n")
+	contents, _ = common.ReadFileContents(filepath.Join(specsDir, "login.cpt"))
+	c.Assert(contents, Equals, "# log in as <user> with <password>\n* open login page\n* enter <user> and <password>\n* check table\n   |name  |secret    |\n   |------|----------|\n   |<user>|<password>|\n\n# sign up\n* open login page\n* enter \"new\" and \"secret\"\n* check table\n     |name|secret|\n     |----|------|\n     |new |secret|\n")
+}
+
+func (s *MySuite) TestInlineConceptAtSelectedUsageAndDeleteUnusedDefinition(c *C) {
+	conceptText := "# greet <name>\n* say \"hello\" to <name>\n\n# wave\n* lift hand\n"
+	specText := "Spec\n====\n\n## Scenario\n* greet \"jane\"\n\n## Another\n* greet \"john\"\n"
+	specsDir, cleanup := createProject(c, map[string]string{"greet.cpt": conceptText, "example.spec": specText})
+	defer cleanup()
+	specFile := filepath.Join(specsDir, "example.spec")
+
+	result := InlineConcept("greet <name>", specFile, 5, true, false)
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(result.warnings, DeepEquals, []string{"Concept 'greet <name>' is still used, its definition is kept"})
+	contents, _ := common.ReadFileContents(specFile)
+	c.Assert(contents, Equals, "Spec\n====\n\n## Scenario\n* say \"hello\" to \"jane\"\n\n## Another\n* greet \"john\"\n")
+
+	result = InlineConcept("greet <name>", "", 0, true, false)
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(result.conceptsChanged, DeepEquals, []string{filepath.Join(specsDir, "greet.cpt")})
+	contents, _ = common.ReadFileContents(filepath.Join(specsDir, "greet.cpt"))
+	c.Assert(contents, Equals, "# wave\n* lift hand\n")
+}
+
+func (s *MySuite) TestInlineConceptReplacesUsagesInContextFilesOnce(c *C) {
+	specsDir, cleanup := createProject(c, map[string]string{"greet.cpt": "# greet <name>\n* say \"hello\" to <name>\n\n# wave\n* lift hand\n", "login.ctx": "* open the app\n* greet \"jane\"\n"})
+	defer cleanup()
+	contextFile := filepath.Join(specsDir, "login.ctx")
+	specText := "Spec\n====\ninclude: " + contextFile + "\n\n## Scenario\n* lift hand\n"
+	for _, name := range []string{"first.spec", "second.spec"} {
+		c.Assert(ioutil.WriteFile(filepath.Join(specsDir, name), []byte(specText), 0644), IsNil)
+	}
+
+	result := InlineConcept("greet <name>", "", 0, true, false)
+
+	c.Assert(result.Errors, DeepEquals, []string{})
+	c.Assert(result.Success, Equals, true)
+	c.Assert(result.specsChanged, DeepEquals, []string{contextFile})
+	contents, _ := common.ReadFileContents(contextFile)
+	c.Assert(contents, Equals, "* open the app\n* say \"hello\" to \"jane\"\n")
+	contents, _ = common.ReadFileContents(filepath.Join(specsDir, "greet.cpt"))
+	c.Assert(contents, Equals, "# wave\n* lift hand\n")
+}
+
+func (s *MySuite) TestInlineConceptFailsForUnknownUsage(c *C) {
+	specsDir, cleanup := createProject(c, map[string]string{"greet.cpt": "# greet\n* say hello\n", "example.spec": "Spec\n====\n\n## Scenario\n* greet\n"})
+	defer cleanup()
+
+	result := InlineConcept("greet", filepath.Join(specsDir, "example.spec"), 4, false, true)
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(result.Errors, DeepEquals, []string{"No usage of concept 'greet' found at " + filepath.Join(specsDir, "example.spec") + ":4"})
+}
+
+func (s *MySuite) TestParseUsage(c *C) {
+	fileName, lineNo, err := parseUsage("specs/a:b.spec:12")
+	c.Assert(err, IsNil)
+	c.Assert(fileName, Equals, "specs/a:b.spec")
+	c.Assert(lineNo, Equals, 12)
+
+	_, _, err = parseUsage("specs/a.spec")
+	c.Assert(err, ErrorMatches, "Invalid usage 'specs/a.spec', expected file:line")
+}
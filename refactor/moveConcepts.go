@@ -0,0 +1,470 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	splitByPrefix = "prefix"
+	splitByUsage  = "usage"
+)
+
+// conceptBlock is the text of a concept definition as written in its file, along with the comments just above
+// its heading and the blank lines before the next concept.
+type conceptBlock struct {
+	concept *parser.Concept
+	start   int
+	end     int
+	lines   []string
+}
+
+type conceptFile struct {
+	fileName string
+	lines    []string
+	concepts []*parser.Step
+	blocks   []*conceptBlock
+}
+
+// conceptMove moves a block to the target file. A move without a target removes the block.
+type conceptMove struct {
+	block  *conceptBlock
+	target string
+}
+
+type conceptsByLine []*parser.Step
+
+func (s conceptsByLine) Len() int           { return len(s) }
+func (s conceptsByLine) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s conceptsByLine) Less(i, j int) bool { return s[i].Span.StartLine < s[j].Span.StartLine }
+
+// MoveConcepts moves the concepts with the given headings to the target concept file, creating it if needed.
+// Definitions of the same concept in other files are removed when they are identical.
+func MoveConcepts(conceptTexts []string, targetFile string, preview bool) *refactoringResult {
+	if !util.IsConcept(targetFile) {
+		return rephraseFailure(fmt.Sprintf("Invalid concept file '%s'", targetFile))
+	}
+	if len(conceptTexts) == 0 {
+		return rephraseFailure("No concepts to move")
+	}
+	target, err := filepath.Abs(targetFile)
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	files, err := parseConceptFiles(append(projectConceptFiles(), target))
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	result := &refactoringResult{Success: true, Errors: make([]string, 0), warnings: make([]string, 0), preview: preview}
+	moves := make([]*conceptMove, 0)
+	for _, conceptText := range conceptTexts {
+		value, err := stepValueOf(conceptText)
+		if err != nil {
+			return rephraseFailure(err.Error())
+		}
+		blocks := definitionsOf(files, value)
+		if len(blocks) == 0 {
+			return rephraseFailure(fmt.Sprintf("Concept '%s' not found", conceptText))
+		}
+		for _, block := range blocks {
+			if block.concept.FileName == target {
+				continue
+			}
+			moves = append(moves, &conceptMove{block: block, target: target})
+		}
+	}
+	return moveConceptBlocks(files, moves, result)
+}
+
+// SplitConceptFile moves the concepts of a file into files of the same directory, named after the first word of
+// their headings when split by prefix, or after the only spec which uses them when split by usage.
+func SplitConceptFile(fileName, by string, preview bool) *refactoringResult {
+	if by != splitByPrefix && by != splitByUsage {
+		return rephraseFailure(fmt.Sprintf("Invalid value '%s', concept files can be split by %s or %s", by, splitByPrefix, splitByUsage))
+	}
+	source, err := filepath.Abs(fileName)
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	files, err := parseConceptFiles(append(projectConceptFiles(), source))
+	if err != nil {
+		return rephraseFailure(err.Error())
+	}
+	result := &refactoringResult{Success: true, Errors: make([]string, 0), warnings: make([]string, 0), preview: preview}
+	file := conceptFileNamed(files, source)
+	if file == nil || len(file.blocks) == 0 {
+		return rephraseFailure(fmt.Sprintf("No concepts found in %s", fileName))
+	}
+	targets := make(map[*conceptBlock]string)
+	if by == splitByPrefix {
+		for _, block := range file.blocks {
+			targets[block] = filepath.Join(filepath.Dir(source), headingPrefix(block.concept.ConceptStep.Value)+".cpt")
+		}
+	} else {
+		specs, err := specsUsingConcepts(files)
+		if err != nil {
+			return rephraseFailure(err.Error())
+		}
+		for _, block := range file.blocks {
+			if specFiles := specs[block.concept.ConceptStep]; len(specFiles) == 1 {
+				specName := strings.TrimSuffix(filepath.Base(specFiles[0]), filepath.Ext(specFiles[0]))
+				targets[block] = filepath.Join(filepath.Dir(source), specName+".cpt")
+			}
+		}
+	}
+	moves := make([]*conceptMove, 0)
+	for _, block := range file.blocks {
+		if target, ok := targets[block]; ok && target != source {
+			moves = append(moves, &conceptMove{block: block, target: target})
+		}
+	}
+	return moveConceptBlocks(files, moves, result)
+}
+
+func projectConceptFiles() []string {
+	return util.FindConceptFilesIn(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName))
+}
+
+// parseConceptFiles parses every concept file on its own, so that the definitions of a concept in more than one
+// file can be found.
+func parseConceptFiles(fileNames []string) ([]*conceptFile, error) {
+	files := make([]*conceptFile, 0)
+	parsed := make(map[string]bool)
+	for _, fileName := range fileNames {
+		fileName, err := filepath.Abs(fileName)
+		if err != nil {
+			return nil, err
+		}
+		if parsed[fileName] || !common.FileExists(fileName) {
+			continue
+		}
+		parsed[fileName] = true
+		file, err := parseConceptFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func parseConceptFile(fileName string) (*conceptFile, error) {
+	source, err := common.ReadFileContents(fileName)
+	if err != nil {
+		return nil, err
+	}
+	concepts, parseResult := new(parser.ConceptParser).Parse(source)
+	if parseResult != nil && parseResult.Error != nil {
+		return nil, fmt.Errorf("%s %s", fileName, parseResult.Error)
+	}
+	sort.Sort(conceptsByLine(concepts))
+	file := &conceptFile{fileName: fileName, lines: strings.Split(source, "\n"), concepts: concepts}
+	starts := make([]int, len(concepts))
+	for i, concept := range concepts {
+		previous := 0
+		if i > 0 {
+			previous = concepts[i-1].Span.StartLine
+		}
+		starts[i] = commentsAbove(file.lines, concept.Span.StartLine, previous)
+	}
+	for i, concept := range concepts {
+		end := len(file.lines)
+		if i+1 < len(concepts) {
+			end = starts[i+1] - 1
+		}
+		block := &conceptBlock{concept: &parser.Concept{ConceptStep: concept, FileName: fileName}, start: starts[i], end: end}
+		block.lines = withoutTrailingBlankLines(file.lines[block.start-1 : block.end])
+		file.blocks = append(file.blocks, block)
+	}
+	return file, nil
+}
+
+// commentsAbove is the first line of the comments written just above a concept heading, after a blank line.
+func commentsAbove(lines []string, headingLine, previousHeadingLine int) int {
+	start := headingLine
+	for start-1 > previousHeadingLine && isCommentLine(lines[start-2]) {
+		start--
+	}
+	if start > 1 && strings.TrimSpace(lines[start-2]) == "" {
+		return start
+	}
+	return headingLine
+}
+
+func isCommentLine(line string) bool {
+	line = strings.TrimSpace(line)
+	return line != "" && !strings.ContainsAny(line[:1], "*#|=-`")
+}
+
+func withoutTrailingBlankLines(lines []string) []string {
+	end := len(lines)
+	for end > 0 && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+	return lines[:end]
+}
+
+func conceptFileNamed(files []*conceptFile, fileName string) *conceptFile {
+	for _, file := range files {
+		if file.fileName == fileName {
+			return file
+		}
+	}
+	return nil
+}
+
+func definitionsOf(files []*conceptFile, value string) []*conceptBlock {
+	blocks := make([]*conceptBlock, 0)
+	for _, file := range files {
+		for _, block := range file.blocks {
+			if block.concept.ConceptStep.Value == value {
+				blocks = append(blocks, block)
+			}
+		}
+	}
+	return blocks
+}
+
+// sameDefinition compares the definitions, leaving out the comments above them and the spacing.
+func sameDefinition(block1, block2 *conceptBlock) bool {
+	return definitionText(block1) == definitionText(block2)
+}
+
+func definitionText(block *conceptBlock) string {
+	lines := make([]string, 0)
+	for _, line := range block.lines[block.concept.ConceptStep.Span.StartLine-block.start:] {
+		lines = append(lines, strings.Join(strings.Fields(line), " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// headingPrefix is the first word of a concept heading, used to name the file it is moved to.
+func headingPrefix(value string) string {
+	for _, word := range strings.Fields(strings.ToLower(value)) {
+		word = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' {
+				return r
+			}
+			return -1
+		}, word)
+		if word != "" {
+			return word
+		}
+	}
+	return "concepts"
+}
+
+// specsUsingConcepts finds the spec files calling each concept directly.
+func specsUsingConcepts(files []*conceptFile) (map[*parser.Step][]string, error) {
+	conceptDictionary := parser.NewConceptDictionary()
+	for _, file := range files {
+		if err := conceptDictionary.Add(file.concepts, file.fileName); err != nil {
+			return nil, fmt.Errorf("%s %s", file.fileName, err)
+		}
+	}
+	specs, parseResults := parser.FindSpecs(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName), conceptDictionary)
+	for _, parseResult := range parseResults {
+		if !parseResult.Ok {
+			return nil, fmt.Errorf("%s", parseResult.Error())
+		}
+	}
+	usages := make(map[*parser.Step][]string)
+	for _, spec := range specs {
+		steps := append(append([]*parser.Step{}, spec.Contexts...), spec.TearDownSteps...)
+		for _, scenario := range spec.Scenarios {
+			steps = append(steps, scenario.Steps...)
+		}
+		used := make(map[*parser.Step]bool)
+		for _, step := range steps {
+			if concept := conceptDictionary.Search(step.Value); step.IsConcept && step.FileName == "" && concept != nil && !used[concept.ConceptStep] {
+				used[concept.ConceptStep] = true
+				usages[concept.ConceptStep] = append(usages[concept.ConceptStep], spec.FileName)
+			}
+		}
+	}
+	return usages, nil
+}
+
+// moveConceptBlocks removes the moved blocks from their files and appends them to the target files, keeping
+// the concepts and comments as written. A concept already defined in the target file is only removed from its
+// file when both definitions are the same.
+func moveConceptBlocks(files []*conceptFile, moves []*conceptMove, result *refactoringResult) *refactoringResult {
+	removed := make(map[*conceptBlock]bool)
+	appended := make(map[string][]*conceptBlock)
+	targets := make([]string, 0)
+	for _, move := range moves {
+		if removed[move.block] {
+			continue
+		}
+		removed[move.block] = true
+		inTarget := definitionsIn(files, move.target, move.block.concept.ConceptStep.Value)
+		for _, block := range appended[move.target] {
+			if block.concept.ConceptStep.Value == move.block.concept.ConceptStep.Value {
+				inTarget = append(inTarget, block)
+			}
+		}
+		if len(inTarget) > 0 {
+			if !sameDefinition(inTarget[0], move.block) {
+				return rephraseFailure(fmt.Sprintf("Concept '%s' is defined differently in %s and %s", heading(move.block), move.block.concept.FileName, move.target))
+			}
+			result.warnings = append(result.warnings, fmt.Sprintf("Removed duplicate definition of concept '%s' from %s", heading(move.block), move.block.concept.FileName))
+			continue
+		}
+		if _, ok := appended[move.target]; !ok {
+			targets = append(targets, move.target)
+		}
+		appended[move.target] = append(appended[move.target], move.block)
+	}
+	result.warnings = append(result.warnings, duplicateDefinitionWarnings(files, removed)...)
+	changes := make(map[string]*fileChange)
+	for _, file := range files {
+		if changed, content := file.without(removed); changed {
+			changes[file.fileName] = &fileChange{fileName: file.fileName, content: content, removed: strings.TrimSpace(content) == ""}
+		}
+	}
+	for _, target := range targets {
+		change, ok := changes[target]
+		if !ok {
+			change = &fileChange{fileName: target}
+			if file := conceptFileNamed(files, target); file != nil {
+				change.content = strings.Join(file.lines, "\n")
+			}
+			changes[target] = change
+		}
+		change.content = withBlocks(change.content, appended[target])
+		change.removed = false
+	}
+	for _, change := range changes {
+		result.fileChanges = append(result.fileChanges, change)
+	}
+	sort.Sort(byFileName(result.fileChanges))
+	result.conceptsChanged = fileNames(result.fileChanges)
+	if !result.preview && len(result.fileChanges) > 0 {
+		if err := result.save(); err != nil {
+			result.Success = false
+			result.Errors = append(result.Errors, fmt.Sprintf("Cannot perform refactoring: %s", err))
+		}
+	}
+	return result
+}
+
+func definitionsIn(files []*conceptFile, fileName, value string) []*conceptBlock {
+	blocks := make([]*conceptBlock, 0)
+	for _, block := range definitionsOf(files, value) {
+		if block.concept.FileName == fileName {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+func heading(block *conceptBlock) string {
+	return strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(block.concept.ConceptStep.LineText), "#"))
+}
+
+// duplicateDefinitionWarnings reports the concepts which are still defined in more than one file.
+func duplicateDefinitionWarnings(files []*conceptFile, removed map[*conceptBlock]bool) []string {
+	definitions := make(map[string][]string)
+	headings := make(map[string]string)
+	values := make([]string, 0)
+	for _, file := range files {
+		for _, block := range file.blocks {
+			value := block.concept.ConceptStep.Value
+			if removed[block] {
+				continue
+			}
+			if _, ok := definitions[value]; !ok {
+				values = append(values, value)
+				headings[value] = heading(block)
+			}
+			definitions[value] = append(definitions[value], file.fileName)
+		}
+	}
+	warnings := make([]string, 0)
+	for _, value := range values {
+		if len(definitions[value]) > 1 {
+			warnings = append(warnings, fmt.Sprintf("Concept '%s' is defined in more than one file: %s", headings[value], strings.Join(definitions[value], ", ")))
+		}
+	}
+	return warnings
+}
+
+// without is the content of the file after removing the given blocks, and whether any block was removed.
+func (file *conceptFile) without(removed map[*conceptBlock]bool) (bool, string) {
+	if len(file.blocks) == 0 {
+		return false, ""
+	}
+	changed := false
+	lines := append([]string{}, file.lines[:file.blocks[0].start-1]...)
+	for _, block := range file.blocks {
+		if removed[block] {
+			changed = true
+			continue
+		}
+		lines = append(lines, file.lines[block.start-1:block.end]...)
+	}
+	lines = withoutTrailingBlankLines(lines)
+	if len(lines) == 0 {
+		return changed, ""
+	}
+	return changed, strings.Join(lines, "\n") + "\n"
+}
+
+// withBlocks appends the blocks to the content, separated by blank lines.
+func withBlocks(content string, blocks []*conceptBlock) string {
+	lines := withoutTrailingBlankLines(strings.Split(content, "\n"))
+	for _, block := range blocks {
+		if len(lines) > 0 {
+			blank := ""
+			if strings.HasSuffix(block.lines[0], "\r") {
+				blank = "\r"
+			}
+			lines = append(lines, blank)
+		}
+		lines = append(lines, block.lines...)
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func RefactorMoveConcepts(conceptTexts []string, targetFile string) {
+	printRefactoringSummary(MoveConcepts(conceptTexts, targetFile, false))
+}
+
+// PreviewMoveConcepts prints the changes moving concepts would make as diffs, without changing any file.
+func PreviewMoveConcepts(conceptTexts []string, targetFile string) {
+	printPreview(MoveConcepts(conceptTexts, targetFile, true))
+}
+
+func RefactorSplitConceptFile(fileName, by string) {
+	printRefactoringSummary(SplitConceptFile(fileName, by, false))
+}
+
+// PreviewSplitConceptFile prints the changes splitting a concept file would make as diffs, without changing any file.
+func PreviewSplitConceptFile(fileName, by string) {
+	printPreview(SplitConceptFile(fileName, by, true))
+}
@@ -0,0 +1,116 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestMoveConceptsKeepsCommentsAndFormatting(c *C) {
+	allConcepts := "Shared concepts\n\n# log in as <user>\n*   enter <user>\n\n// Used by the\n// checkout specs\n# pay with <card>\n* enter card <card>\n   |id|\n   |--|\n   |1 |\n\nNote after payment\n\n# log out\n* click logout\n"
+	specsDir, cleanup := createProject(c, map[string]string{"all.cpt": allConcepts, "example.spec": "Spec\n====\n\n## Scenario\n* log out\n"})
+	defer cleanup()
+	target := filepath.Join(specsDir, "payment.cpt")
+
+	result := MoveConcepts([]string{"pay with <card>", "log in as <user>"}, target, false)
+
+	c.Assert(result.Errors, DeepEquals, []string{})
+	c.Assert(result.conceptsChanged, DeepEquals, []string{filepath.Join(specsDir, "all.cpt"), target})
+	contents, _ := common.ReadFileContents(filepath.Join(specsDir, "all.cpt"))
+	c.Assert(contents, Equals, "Shared concepts\n\n# log out\n* click logout\n")
+	contents, _ = common.ReadFileContents(target)
+	c.Assert(contents, Equals, "// Used by the\n// checkout specs\n# pay with <card>\n* enter card <card>\n   |id|\n   |--|\n   |1 |\n\nNote after payment\n\n# log in as <user>\n*   enter <user>\n")
+}
+
+func (s *MySuite) TestMoveConceptsRemovesIdenticalDuplicateDefinitions(c *C) {
+	specsDir, cleanup := createProject(c, map[string]string{
+		"a.cpt": "# log out\n* click logout\n\n# log in\n* enter user\n",
+		"b.cpt": "# log out\n*  click logout\n",
+	})
+	defer cleanup()
+
+	result := MoveConcepts([]string{"log out"}, filepath.Join(specsDir, "b.cpt"), false)
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(result.warnings, DeepEquals, []string{"Removed duplicate definition of concept 'log out' from " + filepath.Join(specsDir, "a.cpt")})
+	contents, _ := common.ReadFileContents(filepath.Join(specsDir, "a.cpt"))
+	c.Assert(contents, Equals, "# log in\n* enter user\n")
+	contents, _ = common.ReadFileContents(filepath.Join(specsDir, "b.cpt"))
+	c.Assert(contents, Equals, "# log out\n*  click logout\n")
+}
+
+func (s *MySuite) TestMoveConceptsFailsForDifferentDuplicateDefinitions(c *C) {
+	specsDir, cleanup := createProject(c, map[string]string{
+		"a.cpt": "# log out\n* click logout\n",
+		"b.cpt": "# log out\n* press logout\n",
+	})
+	defer cleanup()
+
+	result := MoveConcepts([]string{"log out"}, filepath.Join(specsDir, "b.cpt"), false)
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(result.Errors, DeepEquals, []string{"Concept 'log out' is defined differently in " + filepath.Join(specsDir, "a.cpt") + " and " + filepath.Join(specsDir, "b.cpt")})
+	contents, _ := common.ReadFileContents(filepath.Join(specsDir, "a.cpt"))
+	c.Assert(contents, Equals, "# log out\n* click logout\n")
+}
+
+func (s *MySuite) TestSplitConceptFileByPrefixRemovesEmptiedFile(c *C) {
+	specsDir, cleanup := createProject(c, map[string]string{"all.cpt": "# Login as <user>\n* enter <user>\n\n# logout\n* click logout\n\n# Login with token\n* use token\n"})
+	defer cleanup()
+	source := filepath.Join(specsDir, "all.cpt")
+
+	result := SplitConceptFile(source, "prefix", false)
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(common.FileExists(source), Equals, false)
+	contents, _ := common.ReadFileContents(filepath.Join(specsDir, "login.cpt"))
+	c.Assert(contents, Equals, "# Login as <user>\n* enter <user>\n\n# Login with token\n* use token\n")
+	contents, _ = common.ReadFileContents(filepath.Join(specsDir, "logout.cpt"))
+	c.Assert(contents, Equals, "# logout\n* click logout\n")
+
+	files, err := undoLastTransaction()
+
+	c.Assert(err, IsNil)
+	c.Assert(len(files), Equals, 3)
+	c.Assert(common.FileExists(filepath.Join(specsDir, "login.cpt")), Equals, false)
+	contents, _ = common.ReadFileContents(source)
+	c.Assert(contents, Equals, "# Login as <user>\n* enter <user>\n\n# logout\n* click logout\n\n# Login with token\n* use token\n")
+}
+
+func (s *MySuite) TestSplitConceptFileByUsage(c *C) {
+	specsDir, cleanup := createProject(c, map[string]string{
+		"all.cpt":       "# log in\n* enter user\n\n# log out\n* click logout\n\n# pay\n* enter card\n",
+		"checkout.spec": "Checkout\n========\n\n## Pay\n* log in\n* pay\n",
+		"account.spec":  "Account\n=======\n\n## Leave\n* log in\n* log out\n",
+	})
+	defer cleanup()
+
+	result := SplitConceptFile(filepath.Join(specsDir, "all.cpt"), "usage", true)
+
+	c.Assert(result.Errors, DeepEquals, []string{})
+	c.Assert(len(result.fileChanges), Equals, 3)
+	c.Assert(result.fileChanges[0].fileName, Equals, filepath.Join(specsDir, "account.cpt"))
+	c.Assert(result.fileChanges[0].content, Equals, "# log out\n* click logout\n")
+	c.Assert(result.fileChanges[1].content, Equals, "# log in\n* enter user\n")
+	c.Assert(result.fileChanges[2].fileName, Equals, filepath.Join(specsDir, "checkout.cpt"))
+	c.Assert(result.fileChanges[2].content, Equals, "# pay\n* enter card\n")
+	c.Assert(common.FileExists(filepath.Join(specsDir, "checkout.cpt")), Equals, false)
+}
@@ -28,18 +28,25 @@ import (
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/runner"
-	"github.com/getgauge/gauge/util"
 	"github.com/golang/protobuf/proto"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
+// paramDefaultSeparator separates the name of a new parameter from the value filled in at every usage of the step,
+// as in: pay <amount> to <user=admin>
+const paramDefaultSeparator = "="
+
 type rephraseRefactorer struct {
 	oldStep   *parser.Step
 	newStep   *parser.Step
 	isConcept bool
 	startChan *runner.StartChannels
+	preview   bool
+	// default values of the new parameters, by their position in the new step
+	defaults map[int]string
 }
 
 type refactoringResult struct {
@@ -49,6 +56,15 @@ type refactoringResult struct {
 	runnerFilesChanged []string
 	Errors             []string
 	warnings           []string
+	preview            bool
+	fileChanges        []*fileChange
+}
+
+// fileChange holds the contents of a file after refactoring, or whether the refactoring removes it.
+type fileChange struct {
+	fileName string
+	content  string
+	removed  bool
 }
 
 func (refactoringResult *refactoringResult) String() string {
@@ -61,16 +77,30 @@ func (refactoringResult *refactoringResult) String() string {
 }
 
 func PerformRephraseRefactoring(oldStep, newStep string, startChan *runner.StartChannels) *refactoringResult {
+	return rephrase(oldStep, newStep, startChan, false)
+}
+
+// PreviewRephraseRefactoring finds the changes the refactoring would make to specs, concepts and step implementations,
+// without saving any of them.
+func PreviewRephraseRefactoring(oldStep, newStep string, startChan *runner.StartChannels) *refactoringResult {
+	return rephrase(oldStep, newStep, startChan, true)
+}
+
+func rephrase(oldStep, newStep string, startChan *runner.StartChannels, preview bool) *refactoringResult {
 	defer killRunner(startChan)
 	if newStep == oldStep {
-		return &refactoringResult{Success: true}
+		return &refactoringResult{Success: true, preview: preview}
 	}
 	agent, err := getRefactorAgent(oldStep, newStep, startChan)
 
 	if err != nil {
 		return rephraseFailure(err.Error())
 	}
+	agent.preview = preview
+	return rephraseInProject([]*rephraseRefactorer{agent}, startChan, preview)
+}
 
+func rephraseInProject(agents []*rephraseRefactorer, startChan *runner.StartChannels, preview bool) *refactoringResult {
 	result := &refactoringResult{Success: true, Errors: make([]string, 0), warnings: make([]string, 0)}
 	specs, specParseResults := parser.FindSpecs(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName), &parser.ConceptDictionary{})
 	addErrorsAndWarningsToRefactoringResult(result, specParseResults...)
@@ -84,7 +114,7 @@ func PerformRephraseRefactoring(oldStep, newStep string, startChan *runner.Start
 		return result
 	}
 
-	refactorResult := agent.performRefactoringOn(specs, conceptDictionary)
+	refactorResult := performRefactorings(agents, specs, conceptDictionary, startChan, preview)
 	refactorResult.warnings = append(refactorResult.warnings, result.warnings...)
 	return refactorResult
 }
@@ -108,56 +138,151 @@ func addErrorsAndWarningsToRefactoringResult(refactorResult *refactoringResult,
 }
 
 func (agent *rephraseRefactorer) performRefactoringOn(specs []*parser.Specification, conceptDictionary *parser.ConceptDictionary) *refactoringResult {
-	specsRefactored, conceptFilesRefactored := agent.rephraseInSpecsAndConcepts(&specs, conceptDictionary)
-
-	result := &refactoringResult{Success: false, Errors: make([]string, 0), warnings: make([]string, 0)}
-	if !agent.isConcept {
-		var runner *runner.TestRunner
-		select {
-		case runner = <-agent.startChan.RunnerChan:
-		case err := <-agent.startChan.ErrorChan:
-			result.Errors = append(result.Errors, "Cannot perform refactoring: Unable to connect to runner."+err.Error())
-			return result
+	return performRefactorings([]*rephraseRefactorer{agent}, specs, conceptDictionary, agent.startChan, agent.preview)
+}
+
+// performRefactorings rephrases the steps of every agent in the parsed specs and concepts, and in the step
+// implementations with a single runner session. All the files are written as one transaction.
+func performRefactorings(agents []*rephraseRefactorer, specs []*parser.Specification, conceptDictionary *parser.ConceptDictionary, startChan *runner.StartChannels, preview bool) *refactoringResult {
+	specsRefactored := make(map[*parser.Specification]bool)
+	conceptFilesRefactored := make(map[string]bool)
+	newSteps := make([]*parser.Step, 0)
+	for _, agent := range agents {
+		refactoredSpecs, refactoredConcepts := agent.rephraseInSpecsAndConcepts(&specs, conceptDictionary)
+		for spec, refactored := range refactoredSpecs {
+			specsRefactored[spec] = specsRefactored[spec] || refactored
 		}
-		defer runner.Kill()
-		stepName, err, warning := agent.getStepNameFromRunner(runner)
-		if err != nil {
-			result.Errors = append(result.Errors, err.Error())
-			return result
+		for fileName, refactored := range refactoredConcepts {
+			conceptFilesRefactored[fileName] = conceptFilesRefactored[fileName] || refactored
 		}
-		if warning == nil {
-			runnerFilesChanged, err := agent.requestRunnerForRefactoring(runner, stepName)
-			if err != nil {
-				result.Errors = append(result.Errors, fmt.Sprintf("Cannot perform refactoring: %s", err))
-				return result
+		newSteps = append(newSteps, agent.newStep)
+	}
+
+	result := &refactoringResult{Success: false, Errors: make([]string, 0), warnings: make([]string, 0), preview: preview}
+	var tx *transaction
+	if !preview {
+		tx = &transaction{}
+	}
+	runnerChanges, err := refactorInRunner(agents, startChan, tx, result)
+	if err != nil {
+		if tx != nil {
+			if rollbackErr := tx.rollback(); rollbackErr != nil {
+				err = fmt.Errorf("%s. Restoring the changed files failed too: %s", err, rollbackErr)
 			}
-			result.runnerFilesChanged = runnerFilesChanged
-		} else {
-			result.warnings = append(result.warnings, warning.Message)
+		}
+		result.Errors = append(result.Errors, err.Error())
+		return result
+	}
+	specChanges, conceptChanges := specAndConceptFileChanges(specs, conceptDictionary, specsRefactored, conceptFilesRefactored, newSteps)
+	result.specsChanged = fileNames(specChanges)
+	result.conceptsChanged = fileNames(conceptChanges)
+	result.fileChanges = append(append(specChanges, conceptChanges...), runnerChanges...)
+	if !preview {
+		if err := tx.commit(append(specChanges, conceptChanges...)); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("Cannot perform refactoring: %s", err))
+			return result
+		}
+		if err := tx.keepForUndo(); err != nil {
+			result.warnings = append(result.warnings, fmt.Sprintf("The refactoring cannot be undone: %s", err))
 		}
 	}
-	specFiles, conceptFiles := writeToConceptAndSpecFiles(specs, conceptDictionary, specsRefactored, conceptFilesRefactored)
-	result.specsChanged = specFiles
 	result.Success = true
-	result.conceptsChanged = conceptFiles
 	return result
 }
 
+// refactorInRunner asks the runner to rephrase the step implementations of the agents which do not rephrase
+// concepts. The runner is asked for a dry run, returning its changes instead of saving them, both in a preview,
+// where tx is nil and nothing is written, and in a transaction, which writes the changes as each response
+// arrives, since the runner reads the files for every request.
+func refactorInRunner(agents []*rephraseRefactorer, startChan *runner.StartChannels, tx *transaction, result *refactoringResult) ([]*fileChange, error) {
+	stepAgents := make([]*rephraseRefactorer, 0)
+	for _, agent := range agents {
+		if !agent.isConcept {
+			stepAgents = append(stepAgents, agent)
+		}
+	}
+	runnerChanges := make([]*fileChange, 0)
+	if len(stepAgents) == 0 {
+		return runnerChanges, nil
+	}
+	var testRunner *runner.TestRunner
+	select {
+	case testRunner = <-startChan.RunnerChan:
+	case err := <-startChan.ErrorChan:
+		return nil, errors.New("Cannot perform refactoring: Unable to connect to runner." + err.Error())
+	}
+	defer testRunner.Kill()
+	changedFiles := make(map[string]bool)
+	for _, agent := range stepAgents {
+		stepName, err, warning := agent.getStepNameFromRunner(testRunner)
+		if err != nil {
+			return nil, err
+		}
+		if warning != nil {
+			result.warnings = append(result.warnings, warning.Message)
+			continue
+		}
+		runnerFilesChanged, changes, err := agent.requestRunnerForRefactoring(testRunner, stepName, agent.preview || tx != nil)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot perform refactoring: %s", err)
+		}
+		if tx == nil && len(changes) == 0 {
+			warning := fmt.Sprintf("Changes to the implementation of '%s' cannot be previewed, the language runner does not return them", stepName)
+			if len(runnerFilesChanged) > 0 {
+				warning = fmt.Sprintf("%s and saved them itself: %s", warning, strings.Join(runnerFilesChanged, ", "))
+			}
+			result.warnings = append(result.warnings, warning)
+		} else if len(changes) == 0 && len(runnerFilesChanged) > 0 {
+			result.warnings = append(result.warnings, fmt.Sprintf("The language runner saved its changes itself, they cannot be undone: %s", strings.Join(runnerFilesChanged, ", ")))
+		}
+		for _, fileName := range append(runnerFilesChanged, fileNames(changes)...) {
+			if !changedFiles[fileName] {
+				changedFiles[fileName] = true
+				result.runnerFilesChanged = append(result.runnerFilesChanged, fileName)
+			}
+		}
+		if tx != nil {
+			if err := tx.commit(changes); err != nil {
+				return nil, fmt.Errorf("Cannot perform refactoring: %s", err)
+			}
+		}
+		runnerChanges = append(runnerChanges, changes...)
+	}
+	return runnerChanges, nil
+}
+
+// save writes all the changed files as one transaction and keeps it for gauge --refactor-undo.
+func (refactoringResult *refactoringResult) save() error {
+	tx := &transaction{}
+	if err := tx.commit(refactoringResult.fileChanges); err != nil {
+		return err
+	}
+	if err := tx.keepForUndo(); err != nil {
+		refactoringResult.warnings = append(refactoringResult.warnings, fmt.Sprintf("The refactoring cannot be undone: %s", err))
+	}
+	return nil
+}
+
 func (agent *rephraseRefactorer) rephraseInSpecsAndConcepts(specs *[]*parser.Specification, conceptDictionary *parser.ConceptDictionary) (map[*parser.Specification]bool, map[string]bool) {
 	specsRefactored := make(map[*parser.Specification]bool, 0)
 	conceptFilesRefactored := make(map[string]bool, 0)
 	orderMap := agent.createOrderOfArgs()
+	usageStep := agent.usageStep()
 	for _, spec := range *specs {
-		specsRefactored[spec] = spec.RenameSteps(*agent.oldStep, *agent.newStep, orderMap)
+		specsRefactored[spec] = spec.RenameSteps(*agent.oldStep, *usageStep, orderMap)
 	}
 	isConcept := false
 	for _, concept := range conceptDictionary.ConceptsMap {
 		_, ok := conceptFilesRefactored[concept.FileName]
 		conceptFilesRefactored[concept.FileName] = !ok && false || conceptFilesRefactored[concept.FileName]
 		for _, item := range concept.ConceptStep.Items {
+			newStep := usageStep
+			if item == concept.ConceptStep {
+				newStep = agent.newStep
+			}
 			isRefactored := conceptFilesRefactored[concept.FileName]
 			conceptFilesRefactored[concept.FileName] = item.Kind() == parser.StepKind &&
-				item.(*parser.Step).Rename(*agent.oldStep, *agent.newStep, isRefactored, orderMap, &isConcept) ||
+				item.(*parser.Step).Rename(*agent.oldStep, *newStep, isRefactored, orderMap, &isConcept) ||
 				isRefactored
 		}
 	}
@@ -165,14 +290,56 @@ func (agent *rephraseRefactorer) rephraseInSpecsAndConcepts(specs *[]*parser.Spe
 	return specsRefactored, conceptFilesRefactored
 }
 
+// createOrderOfArgs maps the position of each parameter of the new step to its position in the old step, or to -1
+// for new parameters. Parameters are matched by name, so a static parameter becomes dynamic only when its text is the
+// name of the new parameter, as in `pay "amount"` to `pay <amount>`; other static text makes a new parameter. Usages
+// keep the values they pass, only the step text changes.
 func (agent *rephraseRefactorer) createOrderOfArgs() map[int]int {
 	orderMap := make(map[int]int, len(agent.newStep.Args))
 	for i, arg := range agent.newStep.Args {
-		orderMap[i] = SliceIndex(len(agent.oldStep.Args), func(i int) bool { return agent.oldStep.Args[i].String() == arg.String() })
+		orderMap[i] = SliceIndex(len(agent.oldStep.Args), func(i int) bool { return paramName(agent.oldStep.Args[i]) == paramName(arg) })
 	}
 	return orderMap
 }
 
+func paramName(arg *parser.StepArg) string {
+	if arg.Name != "" {
+		return arg.Name
+	}
+	return arg.Value
+}
+
+// usageStep is the new step as written at its usages, where the new parameters take their default values.
+func (agent *rephraseRefactorer) usageStep() *parser.Step {
+	if len(agent.defaults) == 0 {
+		return agent.newStep
+	}
+	step := *agent.newStep
+	step.Args = make([]*parser.StepArg, len(agent.newStep.Args))
+	for i, arg := range agent.newStep.Args {
+		if value, ok := agent.defaults[i]; ok {
+			arg = &parser.StepArg{Value: value, ArgType: parser.Static}
+		}
+		step.Args[i] = arg
+	}
+	return &step
+}
+
+// takeParamDefaults removes the default values from the dynamic parameters written as <name=default>.
+func takeParamDefaults(step *parser.Step) map[int]string {
+	defaults := make(map[int]string)
+	for i, arg := range step.Args {
+		index := strings.Index(arg.Value, paramDefaultSeparator)
+		if arg.ArgType != parser.Dynamic || index == -1 {
+			continue
+		}
+		defaults[i] = arg.Value[index+len(paramDefaultSeparator):]
+		arg.Name = arg.Value[:index]
+		arg.Value = arg.Name
+	}
+	return defaults
+}
+
 func SliceIndex(limit int, predicate func(i int) bool) int {
 	for i := 0; i < limit; i++ {
 		if predicate(i) {
@@ -197,13 +364,20 @@ func getRefactorAgent(oldStepText, newStepText string, startChan *runner.StartCh
 		}
 		steps = append(steps, step)
 	}
-	return &rephraseRefactorer{oldStep: steps[0], newStep: steps[1], startChan: startChan}, nil
+	agent := &rephraseRefactorer{oldStep: steps[0], newStep: steps[1], startChan: startChan, defaults: takeParamDefaults(steps[1])}
+	orderMap := agent.createOrderOfArgs()
+	for i := range agent.defaults {
+		if orderMap[i] != -1 {
+			return nil, fmt.Errorf("Default value given for <%s>, which is not a new parameter", paramName(agent.newStep.Args[i]))
+		}
+	}
+	return agent, nil
 }
 
-func (agent *rephraseRefactorer) requestRunnerForRefactoring(testRunner *runner.TestRunner, stepName string) ([]string, error) {
-	refactorRequest, err := agent.createRefactorRequest(testRunner, stepName)
+func (agent *rephraseRefactorer) requestRunnerForRefactoring(testRunner *runner.TestRunner, stepName string, dryRun bool) ([]string, []*fileChange, error) {
+	refactorRequest, err := agent.createRefactorRequest(testRunner, stepName, dryRun)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	refactorResponse := agent.sendRefactorRequest(testRunner, refactorRequest)
 	var runnerError error
@@ -211,7 +385,11 @@ func (agent *rephraseRefactorer) requestRunnerForRefactoring(testRunner *runner.
 		logger.ApiLog.Error("Refactoring error response from runner: %v", refactorResponse.GetError())
 		runnerError = errors.New(refactorResponse.GetError())
 	}
-	return refactorResponse.GetFilesChanged(), runnerError
+	changes := make([]*fileChange, 0)
+	for _, change := range refactorResponse.GetFileChanges() {
+		changes = append(changes, &fileChange{fileName: change.GetFileName(), content: change.GetFileContent()})
+	}
+	return refactorResponse.GetFilesChanged(), changes, runnerError
 }
 
 func (agent *rephraseRefactorer) sendRefactorRequest(testRunner *runner.TestRunner, refactorRequest *gauge_messages.Message) *gauge_messages.RefactorResponse {
@@ -223,7 +401,7 @@ func (agent *rephraseRefactorer) sendRefactorRequest(testRunner *runner.TestRunn
 }
 
 //Todo: Check for inline tables
-func (agent *rephraseRefactorer) createRefactorRequest(runner *runner.TestRunner, stepName string) (*gauge_messages.Message, error) {
+func (agent *rephraseRefactorer) createRefactorRequest(runner *runner.TestRunner, stepName string, dryRun bool) (*gauge_messages.Message, error) {
 	oldStepValue, err := agent.getStepValueFor(agent.oldStep, stepName)
 	if err != nil {
 		return nil, err
@@ -236,7 +414,7 @@ func (agent *rephraseRefactorer) createRefactorRequest(runner *runner.TestRunner
 	}
 	oldProtoStepValue := parser.ConvertToProtoStepValue(oldStepValue)
 	newProtoStepValue := parser.ConvertToProtoStepValue(newStepValue)
-	return &gauge_messages.Message{MessageType: gauge_messages.Message_RefactorRequest.Enum(), RefactorRequest: &gauge_messages.RefactorRequest{OldStepValue: oldProtoStepValue, NewStepValue: newProtoStepValue, ParamPositions: agent.createParameterPositions(orderMap)}}, nil
+	return &gauge_messages.Message{MessageType: gauge_messages.Message_RefactorRequest.Enum(), RefactorRequest: &gauge_messages.RefactorRequest{OldStepValue: oldProtoStepValue, NewStepValue: newProtoStepValue, ParamPositions: agent.createParameterPositions(orderMap), DryRun: proto.Bool(dryRun)}}, nil
 }
 
 func (agent *rephraseRefactorer) generateNewStepName(args []string, orderMap map[int]int) string {
@@ -270,8 +448,8 @@ func (agent *rephraseRefactorer) getStepNameFromRunner(runner *runner.TestRunner
 
 func (agent *rephraseRefactorer) createParameterPositions(orderMap map[int]int) []*gauge_messages.ParameterPosition {
 	paramPositions := make([]*gauge_messages.ParameterPosition, 0)
-	for k, v := range orderMap {
-		paramPositions = append(paramPositions, &gauge_messages.ParameterPosition{NewPosition: proto.Int(k), OldPosition: proto.Int(v)})
+	for k := 0; k < len(orderMap); k++ {
+		paramPositions = append(paramPositions, &gauge_messages.ParameterPosition{NewPosition: proto.Int(k), OldPosition: proto.Int(orderMap[k])})
 	}
 	return paramPositions
 }
@@ -280,24 +458,136 @@ func (agent *rephraseRefactorer) getStepValueFor(step *parser.Step, stepName str
 	return parser.ExtractStepValueAndParams(stepName, false)
 }
 
-func writeToConceptAndSpecFiles(specs []*parser.Specification, conceptDictionary *parser.ConceptDictionary, specsRefactored map[*parser.Specification]bool, conceptFilesRefactored map[string]bool) ([]string, []string) {
-	specFiles := make([]string, 0)
-	conceptFiles := make([]string, 0)
+func specAndConceptFileChanges(specs []*parser.Specification, conceptDictionary *parser.ConceptDictionary, specsRefactored map[*parser.Specification]bool, conceptFilesRefactored map[string]bool, newSteps []*parser.Step) ([]*fileChange, []*fileChange) {
+	specChanges := make([]*fileChange, 0)
+	conceptChanges := make([]*fileChange, 0)
+	contextFilesRefactored := make(map[string]bool, 0)
 	for _, spec := range specs {
-		if specsRefactored[spec] {
-			specFiles = append(specFiles, spec.FileName)
-			formatted := formatter.FormatSpecification(spec)
-			util.SaveFile(spec.FileName, formatted, true)
+		if !specsRefactored[spec] {
+			continue
+		}
+		if steps := renamedStepsInSpec(spec, newSteps); len(steps) > 0 {
+			formatted, ok := formatStepsInFile(spec.FileName, steps)
+			if !ok {
+				formatted = formatter.FormatSpecification(spec)
+			}
+			specChanges = append(specChanges, &fileChange{fileName: spec.FileName, content: formatted})
+		}
+		for _, include := range spec.Includes {
+			steps := renamedStepsInInclude(include, newSteps)
+			if len(steps) == 0 || contextFilesRefactored[steps[0].FileName] {
+				continue
+			}
+			contextFilesRefactored[steps[0].FileName] = true
+			if formatted, ok := formatStepsInFile(steps[0].FileName, steps); ok {
+				specChanges = append(specChanges, &fileChange{fileName: steps[0].FileName, content: formatted})
+			}
 		}
 	}
 	conceptMap := formatter.FormatConcepts(conceptDictionary)
 	for fileName, concept := range conceptMap {
 		if conceptFilesRefactored[fileName] {
-			conceptFiles = append(conceptFiles, fileName)
-			util.SaveFile(fileName, concept, true)
+			if formatted, ok := formatStepsInFile(fileName, renamedStepsInConcepts(fileName, conceptDictionary, newSteps)); ok {
+				concept = formatted
+			}
+			conceptChanges = append(conceptChanges, &fileChange{fileName: fileName, content: concept})
+		}
+	}
+	sort.Sort(byFileName(conceptChanges))
+	return specChanges, conceptChanges
+}
+
+type byFileName []*fileChange
+
+func (s byFileName) Len() int           { return len(s) }
+func (s byFileName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byFileName) Less(i, j int) bool { return s[i].fileName < s[j].fileName }
+
+func fileNames(changes []*fileChange) []string {
+	names := make([]string, 0)
+	for _, change := range changes {
+		names = append(names, change.fileName)
+	}
+	return names
+}
+
+// diff compares the contents after refactoring with the file as it is on disk.
+func (change *fileChange) diff() string {
+	original, err := common.ReadFileContents(change.fileName)
+	if err != nil {
+		original = ""
+	}
+	return formatter.UnifiedDiff(change.fileName, change.fileName+" (refactored)", original, change.content)
+}
+
+// FileChanges gives the new contents and the diff of every file changed by the refactoring.
+func (refactoringResult *refactoringResult) FileChanges() []*gauge_messages.FileChanges {
+	changes := make([]*gauge_messages.FileChanges, 0)
+	for _, change := range refactoringResult.fileChanges {
+		changes = append(changes, &gauge_messages.FileChanges{FileName: proto.String(change.fileName), FileContent: proto.String(change.content), Diff: proto.String(change.diff())})
+	}
+	return changes
+}
+
+// formatStepsInFile rewrites only the lines of the renamed steps, so the rest of the file is left as the user wrote it.
+func formatStepsInFile(fileName string, steps []*parser.Step) (string, bool) {
+	source, err := common.ReadFileContents(fileName)
+	if err != nil {
+		return "", false
+	}
+	return formatter.FormatStepsIn(source, steps)
+}
+
+// renamedStepsInSpec leaves out the steps included from context files, their lines are in the context file.
+func renamedStepsInSpec(spec *parser.Specification, newSteps []*parser.Step) []*parser.Step {
+	steps := make([]*parser.Step, 0)
+	for _, step := range spec.Contexts {
+		if step.FileName == "" && isRenamedTo(step, newSteps) {
+			steps = append(steps, step)
+		}
+	}
+	for _, scenario := range spec.Scenarios {
+		for _, step := range scenario.Steps {
+			if isRenamedTo(step, newSteps) {
+				steps = append(steps, step)
+			}
+		}
+	}
+	return steps
+}
+
+func renamedStepsInInclude(include *parser.Include, newSteps []*parser.Step) []*parser.Step {
+	steps := make([]*parser.Step, 0)
+	for _, step := range include.ContextSteps {
+		if isRenamedTo(step, newSteps) {
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
+func renamedStepsInConcepts(fileName string, conceptDictionary *parser.ConceptDictionary, newSteps []*parser.Step) []*parser.Step {
+	steps := make([]*parser.Step, 0)
+	for _, concept := range conceptDictionary.ConceptsMap {
+		if concept.FileName != fileName {
+			continue
+		}
+		for _, item := range concept.ConceptStep.Items {
+			if item.Kind() == parser.StepKind && isRenamedTo(item.(*parser.Step), newSteps) {
+				steps = append(steps, item.(*parser.Step))
+			}
 		}
 	}
-	return specFiles, conceptFiles
+	return steps
+}
+
+func isRenamedTo(step *parser.Step, newSteps []*parser.Step) bool {
+	for _, newStep := range newSteps {
+		if step.Value == newStep.Value {
+			return true
+		}
+	}
+	return false
 }
 
 func (refactoringResult *refactoringResult) appendWarnings(warnings []*parser.Warning) {
@@ -328,9 +618,13 @@ func printRefactoringSummary(refactoringResult *refactoringResult) {
 	for _, warning := range refactoringResult.warnings {
 		logger.Warning("%s \n", warning)
 	}
-	logger.Info("%d specifications changed.\n", len(refactoringResult.specsChanged))
-	logger.Info("%d concepts changed.\n", len(refactoringResult.conceptsChanged))
-	logger.Info("%d files in code changed.\n", len(refactoringResult.runnerFilesChanged))
+	changed := "changed"
+	if refactoringResult.preview {
+		changed = "would be changed"
+	}
+	logger.Info("%d specifications %s.\n", len(refactoringResult.specsChanged), changed)
+	logger.Info("%d concepts %s.\n", len(refactoringResult.conceptsChanged), changed)
+	logger.Info("%d files in code %s.\n", len(refactoringResult.runnerFilesChanged), changed)
 	os.Exit(exitCode)
 }
 
@@ -338,3 +632,27 @@ func RefactorSteps(oldStep, newStep string, startChan *runner.StartChannels) {
 	refactoringResult := PerformRephraseRefactoring(oldStep, newStep, startChan)
 	printRefactoringSummary(refactoringResult)
 }
+
+// UndoRefactoring restores the files changed by the last refactoring.
+func UndoRefactoring() {
+	files, err := undoLastTransaction()
+	if err != nil {
+		logger.Fatal("%s", err)
+	}
+	for _, file := range files {
+		logger.Info("Restored %s\n", file)
+	}
+	logger.Info("%d files restored.\n", len(files))
+}
+
+func printPreview(refactoringResult *refactoringResult) {
+	for _, change := range refactoringResult.fileChanges {
+		fmt.Print(change.diff())
+	}
+	printRefactoringSummary(refactoringResult)
+}
+
+// PreviewRefactorSteps prints the changes refactoring would make as diffs, without changing any file.
+func PreviewRefactorSteps(oldStep, newStep string, startChan *runner.StartChannels) {
+	printPreview(PreviewRephraseRefactoring(oldStep, newStep, startChan))
+}
@@ -18,8 +18,17 @@
 package refactor
 
 import (
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/conn"
+	"github.com/getgauge/gauge/gauge_messages"
 	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/runner"
+	"github.com/golang/protobuf/proto"
 	. "gopkg.in/check.v1"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -222,7 +231,7 @@ func (s *MySuite) TestCreateOrderGivesMapOfOldArgsAndNewArgs(c *C) {
 	step1 := &parser.Step{Args: []*parser.StepArg{&parser.StepArg{Name: "a"}, &parser.StepArg{Name: "b"}, &parser.StepArg{Name: "c"}, &parser.StepArg{Name: "d"}}}
 	step2 := &parser.Step{Args: []*parser.StepArg{&parser.StepArg{Name: "d"}, &parser.StepArg{Name: "b"}, &parser.StepArg{Name: "c"}, &parser.StepArg{Name: "a"}}}
 
-	agent := &rephraseRefactorer{step1, step2, false, nil}
+	agent := &rephraseRefactorer{oldStep: step1, newStep: step2}
 	orderMap := agent.createOrderOfArgs()
 
 	c.Assert(orderMap[0], Equals, 3)
@@ -234,7 +243,7 @@ func (s *MySuite) TestCreateOrderGivesMapOfOldArgsAndNewWhenArgsAreAdded(c *C) {
 	step1 := &parser.Step{Args: []*parser.StepArg{&parser.StepArg{Name: "a"}, &parser.StepArg{Name: "b"}, &parser.StepArg{Name: "c"}, &parser.StepArg{Name: "d"}}}
 	step2 := &parser.Step{Args: []*parser.StepArg{&parser.StepArg{Name: "d"}, &parser.StepArg{Name: "e"}, &parser.StepArg{Name: "b"}, &parser.StepArg{Name: "c"}, &parser.StepArg{Name: "a"}}}
 
-	agent := &rephraseRefactorer{step1, step2, false, nil}
+	agent := &rephraseRefactorer{oldStep: step1, newStep: step2}
 	orderMap := agent.createOrderOfArgs()
 
 	c.Assert(orderMap[0], Equals, 3)
@@ -248,7 +257,7 @@ func (s *MySuite) TestCreateOrderGivesMapOfOldArgsAndNewWhenArgsAreRemoved(c *C)
 	step1 := &parser.Step{Args: []*parser.StepArg{&parser.StepArg{Name: "a"}, &parser.StepArg{Name: "b"}, &parser.StepArg{Name: "c"}, &parser.StepArg{Name: "d"}}}
 	step2 := &parser.Step{Args: []*parser.StepArg{&parser.StepArg{Name: "d"}, &parser.StepArg{Name: "b"}, &parser.StepArg{Name: "c"}}}
 
-	agent := &rephraseRefactorer{step1, step2, false, nil}
+	agent := &rephraseRefactorer{oldStep: step1, newStep: step2}
 	orderMap := agent.createOrderOfArgs()
 
 	c.Assert(orderMap[0], Equals, 3)
@@ -442,3 +451,251 @@ func (s *MySuite) TestGenerateNewStepNameWhenParametersAreUnchanged(c *C) {
 
 	c.Assert(linetext, Equals, "make comment <a>")
 }
+
+func (s *MySuite) TestRenamingStepWithTextBlock(c *C) {
+	oldStep := "post request <body>"
+	newStep := "post <body> to the server"
+	tokens := []*parser.Token{
+		&parser.Token{Kind: parser.SpecKind, Value: "Spec Heading", LineNo: 1},
+		&parser.Token{Kind: parser.ScenarioKind, Value: "Scenario Heading", LineNo: 2},
+		&parser.Token{Kind: parser.StepKind, Value: "post request", LineNo: 3},
+		&parser.Token{Kind: parser.TextBlockKind, Value: "{}", Args: []string{"json"}, LineNo: 4},
+	}
+	spec, _ := new(parser.SpecParser).CreateSpecification(tokens, new(parser.ConceptDictionary))
+	agent, _ := getRefactorAgent(oldStep, newStep, nil)
+	specs := append(make([]*parser.Specification, 0), spec)
+	agent.rephraseInSpecsAndConcepts(&specs, new(parser.ConceptDictionary))
+
+	step := specs[0].Scenarios[0].Steps[0]
+	c.Assert(step.Value, Equals, "post {} to the server")
+	c.Assert(step.HasTextBlock, Equals, true)
+	c.Assert(step.Args[0].ArgType, Equals, parser.TextBlock)
+	c.Assert(step.Args[0].Value, Equals, "{}")
+}
+
+func (s *MySuite) TestPreviewOfRefactoringDoesNotChangeFiles(c *C) {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	conceptFile := filepath.Join(dir, "login.cpt")
+	conceptText := "# log in as <user>\n* enter <user>\n\nNotes on   logging in\n"
+	c.Assert(ioutil.WriteFile(conceptFile, []byte(conceptText), 0644), IsNil)
+	dictionary := parser.NewConceptDictionary()
+	c.Assert(parser.AddConcepts(conceptFile, dictionary), IsNil)
+	agent, _ := getRefactorAgent("log in as <user>", "sign in as <user>", nil)
+	agent.preview = true
+
+	result := agent.performRefactoringOn(make([]*parser.Specification, 0), dictionary)
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(result.conceptsChanged, DeepEquals, []string{conceptFile})
+	contents, _ := common.ReadFileContents(conceptFile)
+	c.Assert(contents, Equals, conceptText)
+	changes := result.FileChanges()
+	c.Assert(len(changes), Equals, 1)
+	c.Assert(changes[0].GetFileContent(), Equals, "# sign in as <user>\n* enter <user>\n\nNotes on   logging in\n")
+	c.Assert(changes[0].GetDiff(), Equals, "--- "+conceptFile+"\n+++ "+conceptFile+" (refactored)\n@@ -1,4 +1,4 @@\n-# log in as <user>\n+# sign in as <user>\n * enter <user>\n \n Notes on   logging in\n")
+}
+
+func (s *MySuite) TestRenamingFillsDefaultValueOfAddedArgument(c *C) {
+	oldStep := "first step {static} and {static}"
+	tokens := []*parser.Token{
+		&parser.Token{Kind: parser.SpecKind, Value: "Spec Heading", LineNo: 1},
+		&parser.Token{Kind: parser.ScenarioKind, Value: "Scenario Heading 1", LineNo: 2},
+		&parser.Token{Kind: parser.StepKind, Value: oldStep, LineNo: 3, Args: []string{"name", "address"}},
+	}
+	spec, _ := new(parser.SpecParser).CreateSpecification(tokens, new(parser.ConceptDictionary))
+	agent, err := getRefactorAgent("first step <a> and <b>", "second step <b> and <c=none> and <a>", nil)
+	c.Assert(err, IsNil)
+	specs := append(make([]*parser.Specification, 0), spec)
+	agent.rephraseInSpecsAndConcepts(&specs, new(parser.ConceptDictionary))
+
+	step := specs[0].Scenarios[0].Steps[0]
+	c.Assert(step.Value, Equals, "second step {} and {} and {}")
+	c.Assert(step.Args[0].Value, Equals, "address")
+	c.Assert(*step.Args[1], DeepEquals, parser.StepArg{Value: "none", ArgType: parser.Static})
+	c.Assert(step.Args[2].Value, Equals, "name")
+	c.Assert(agent.generateNewStepName([]string{"a", "b"}, agent.createOrderOfArgs()), Equals, "second step <b> and <c> and <a>")
+}
+
+func (s *MySuite) TestCreateOrderMatchesStaticArgumentMadeDynamic(c *C) {
+	agent, err := getRefactorAgent("pay \"amount\" to <user>", "pay <user> the <amount>", nil)
+	c.Assert(err, IsNil)
+
+	orderMap := agent.createOrderOfArgs()
+
+	c.Assert(orderMap[0], Equals, 1)
+	c.Assert(orderMap[1], Equals, 0)
+}
+
+func (s *MySuite) TestRenamingStaticArgumentMadeDynamicKeepsValuesOfUsages(c *C) {
+	tokens := []*parser.Token{
+		&parser.Token{Kind: parser.SpecKind, Value: "Spec Heading", LineNo: 1},
+		&parser.Token{Kind: parser.ScenarioKind, Value: "Scenario Heading 1", LineNo: 2},
+		&parser.Token{Kind: parser.StepKind, Value: "pay {static} to {static}", LineNo: 3, Args: []string{"amount", "jane"}},
+	}
+	spec, _ := new(parser.SpecParser).CreateSpecification(tokens, new(parser.ConceptDictionary))
+	agent, err := getRefactorAgent("pay \"amount\" to <user>", "pay <user> the <amount>", nil)
+	c.Assert(err, IsNil)
+	specs := append(make([]*parser.Specification, 0), spec)
+	agent.rephraseInSpecsAndConcepts(&specs, new(parser.ConceptDictionary))
+
+	step := specs[0].Scenarios[0].Steps[0]
+	c.Assert(step.Value, Equals, "pay {} the {}")
+	c.Assert(*step.Args[0], DeepEquals, parser.StepArg{Value: "jane", ArgType: parser.Static})
+	c.Assert(*step.Args[1], DeepEquals, parser.StepArg{Value: "amount", ArgType: parser.Static})
+}
+
+func (s *MySuite) TestCreateOrderTreatsStaticTextOtherThanTheNameAsNewArgument(c *C) {
+	agent, err := getRefactorAgent("pay \"10\" to <user>", "pay <user> the <amount=10>", nil)
+	c.Assert(err, IsNil)
+
+	orderMap := agent.createOrderOfArgs()
+
+	c.Assert(orderMap[0], Equals, 1)
+	c.Assert(orderMap[1], Equals, -1)
+}
+
+func (s *MySuite) TestDefaultValueIsOnlyAllowedForNewArguments(c *C) {
+	_, err := getRefactorAgent("log in as <user>", "log in as <user=admin>", nil)
+
+	c.Assert(err, ErrorMatches, "Default value given for <user>, which is not a new parameter")
+}
+
+func (s *MySuite) TestCreateParameterPositionsGivesNewArgumentsAnOldPositionOfMinusOne(c *C) {
+	agent, _ := getRefactorAgent("pay <amount> to <user> for <item>", "pay <user> for <reason=none> the <amount>", nil)
+
+	positions := agent.createParameterPositions(agent.createOrderOfArgs())
+
+	c.Assert(len(positions), Equals, 3)
+	for i, oldPosition := range []int32{1, -1, 0} {
+		c.Assert(positions[i].GetNewPosition(), Equals, int32(i))
+		c.Assert(positions[i].GetOldPosition(), Equals, oldPosition)
+	}
+}
+
+func (s *MySuite) TestRenamingConceptKeepsHeadingArgumentDynamicAndFillsDefaultInUsages(c *C) {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	conceptFile := filepath.Join(dir, "login.cpt")
+	c.Assert(ioutil.WriteFile(conceptFile, []byte("# log in as <user>\n* enter <user>\n\n# start as <name>\n* log in as <name>\n"), 0644), IsNil)
+	dictionary := parser.NewConceptDictionary()
+	c.Assert(parser.AddConcepts(conceptFile, dictionary), IsNil)
+	agent, err := getRefactorAgent("log in as <user>", "log in as <user> with <password=secret>", nil)
+	c.Assert(err, IsNil)
+	agent.preview = true
+
+	result := agent.performRefactoringOn(make([]*parser.Specification, 0), dictionary)
+
+	c.Assert(result.Success, Equals, true)
+	changes := result.FileChanges()
+	c.Assert(len(changes), Equals, 1)
+	c.Assert(changes[0].GetFileContent(), Equals, "# log in as <user> with <password>\n* enter <user>\n\n# start as <name>\n* log in as <name> with \"secret\"\n")
+}
+
+func (s *MySuite) TestRenamingStepUsedOnlyThroughAnIncludeChangesTheContextFile(c *C) {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	contextFile := filepath.Join(dir, "login.ctx")
+	c.Assert(ioutil.WriteFile(contextFile, []byte("* open the app\n\n* log in\n"), 0644), IsNil)
+	specText := "Spec\n====\ninclude: " + contextFile + "\n\nScenario\n--------\n* check the dashboard\n"
+	for _, name := range []string{"first.spec", "second.spec"} {
+		c.Assert(ioutil.WriteFile(filepath.Join(dir, name), []byte(specText), 0644), IsNil)
+	}
+	specs, _ := parser.FindSpecs(dir, new(parser.ConceptDictionary))
+	c.Assert(len(specs), Equals, 2)
+	agent, err := getRefactorAgent("log in", "sign in", nil)
+	c.Assert(err, IsNil)
+	specsRefactored, _ := agent.rephraseInSpecsAndConcepts(&specs, new(parser.ConceptDictionary))
+
+	specChanges, conceptChanges := specAndConceptFileChanges(specs, new(parser.ConceptDictionary), specsRefactored, nil, []*parser.Step{agent.newStep})
+
+	c.Assert(len(conceptChanges), Equals, 0)
+	c.Assert(len(specChanges), Equals, 1)
+	c.Assert(specChanges[0].fileName, Equals, contextFile)
+	c.Assert(specChanges[0].content, Equals, "* open the app\n\n* sign in\n")
+}
+
+// fakeRunner answers step name requests for any step and refactor requests with the given file changes, and records
+// every request it gets.
+func fakeRunner(requests *[]*gauge_messages.Message, fileChanges []*gauge_messages.FileChanges) *runner.StartChannels {
+	gaugeEnd, runnerEnd := net.Pipe()
+	go func() {
+		data := make([]byte, 8192)
+		for {
+			n, err := runnerEnd.Read(data)
+			if err != nil {
+				return
+			}
+			length, read := proto.DecodeVarint(data[:n])
+			request := &gauge_messages.Message{}
+			if err := proto.Unmarshal(data[read:read+int(length)], request); err != nil {
+				return
+			}
+			*requests = append(*requests, request)
+			response := &gauge_messages.Message{MessageId: request.MessageId, MessageType: gauge_messages.Message_RefactorResponse.Enum(), RefactorResponse: &gauge_messages.RefactorResponse{Success: proto.Bool(true), FileChanges: fileChanges}}
+			if request.GetMessageType() == gauge_messages.Message_StepNameRequest {
+				response = &gauge_messages.Message{MessageId: request.MessageId, MessageType: gauge_messages.Message_StepNameResponse.Enum(),
+					StepNameResponse: &gauge_messages.StepNameResponse{IsStepPresent: proto.Bool(true), HasAlias: proto.Bool(false), StepName: []string{request.GetStepNameRequest().GetStepValue()}}}
+			}
+			responseBytes, _ := proto.Marshal(response)
+			conn.Write(runnerEnd, responseBytes)
+		}
+	}()
+	startChan := &runner.StartChannels{RunnerChan: make(chan *runner.TestRunner, 1), ErrorChan: make(chan error, 1), KillChan: make(chan bool, 1)}
+	startChan.RunnerChan <- &runner.TestRunner{Connection: gaugeEnd}
+	return startChan
+}
+
+func previewAgent(c *C, startChan *runner.StartChannels) (*rephraseRefactorer, *parser.Specification) {
+	tokens := []*parser.Token{
+		&parser.Token{Kind: parser.SpecKind, Value: "Spec Heading", LineNo: 1},
+		&parser.Token{Kind: parser.ScenarioKind, Value: "Scenario Heading", LineNo: 2},
+		&parser.Token{Kind: parser.StepKind, Value: "log in", LineNo: 3},
+	}
+	spec, _ := new(parser.SpecParser).CreateSpecification(tokens, new(parser.ConceptDictionary))
+	agent, err := getRefactorAgent("log in", "sign in", startChan)
+	c.Assert(err, IsNil)
+	agent.preview = true
+	return agent, spec
+}
+
+func (s *MySuite) TestPreviewDiffsTheChangesOfADryRunInTheRunner(c *C) {
+	dir, err := ioutil.TempDir("", "gauge-refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	stepImplementation := filepath.Join(dir, "StepImplementation.java")
+	c.Assert(ioutil.WriteFile(stepImplementation, []byte("@Step(\"log in\")\n"), 0644), IsNil)
+	requests := make([]*gauge_messages.Message, 0)
+	runnerChanges := []*gauge_messages.FileChanges{&gauge_messages.FileChanges{FileName: proto.String(stepImplementation), FileContent: proto.String("@Step(\"sign in\")\n")}}
+	agent, spec := previewAgent(c, fakeRunner(&requests, runnerChanges))
+
+	result := agent.performRefactoringOn([]*parser.Specification{spec}, new(parser.ConceptDictionary))
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(len(requests), Equals, 2)
+	c.Assert(requests[1].GetMessageType(), Equals, gauge_messages.Message_RefactorRequest)
+	c.Assert(requests[1].GetRefactorRequest().GetDryRun(), Equals, true)
+	c.Assert(len(result.warnings), Equals, 0)
+	c.Assert(result.runnerFilesChanged, DeepEquals, []string{stepImplementation})
+	runnerChange := result.fileChanges[len(result.fileChanges)-1]
+	c.Assert(runnerChange.diff(), Equals, "--- "+stepImplementation+"\n+++ "+stepImplementation+" (refactored)\n@@ -1 +1 @@\n-@Step(\"log in\")\n+@Step(\"sign in\")\n")
+	contents, err := ioutil.ReadFile(stepImplementation)
+	c.Assert(err, IsNil)
+	c.Assert(string(contents), Equals, "@Step(\"log in\")\n")
+}
+
+func (s *MySuite) TestPreviewWarnsWhenTheRunnerDoesNotReturnItsChanges(c *C) {
+	requests := make([]*gauge_messages.Message, 0)
+	agent, spec := previewAgent(c, fakeRunner(&requests, nil))
+
+	result := agent.performRefactoringOn([]*parser.Specification{spec}, new(parser.ConceptDictionary))
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(len(requests), Equals, 2)
+	c.Assert(requests[1].GetRefactorRequest().GetDryRun(), Equals, true)
+	c.Assert(result.warnings, DeepEquals, []string{"Changes to the implementation of 'log in' cannot be previewed, the language runner does not return them"})
+	c.Assert(len(result.runnerFilesChanged), Equals, 0)
+}
@@ -0,0 +1,344 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+)
+
+// RenameTags renames the comma separated oldTags to newTag in every spec and scenario. Tags renamed onto a tag
+// that is already present are merged into it.
+func RenameTags(oldTags, newTag string, preview bool) *refactoringResult {
+	result := &refactoringResult{Success: false, Errors: make([]string, 0), warnings: make([]string, 0), preview: preview}
+	renames, err := tagRenames(oldTags, newTag)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+		return result
+	}
+	specs, parseResults := parser.FindSpecs(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName), &parser.ConceptDictionary{})
+	result.Success = true
+	addErrorsAndWarningsToRefactoringResult(result, parseResults...)
+	if !result.Success {
+		return result
+	}
+	result.fileChanges = renameTagsIn(specs, renames, newTag)
+	result.specsChanged = fileNames(result.fileChanges)
+	if !preview {
+		if err := result.save(); err != nil {
+			result.Success = false
+			result.Errors = append(result.Errors, fmt.Sprintf("Cannot perform refactoring: %s", err))
+		}
+	}
+	return result
+}
+
+func tagRenames(oldTags, newTag string) (map[string]bool, error) {
+	newTag = strings.TrimSpace(newTag)
+	if newTag == "" || strings.Contains(newTag, ",") {
+		return nil, fmt.Errorf("Invalid tag name '%s'", newTag)
+	}
+	renames := make(map[string]bool)
+	for _, tag := range strings.Split(oldTags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" && tag != newTag {
+			renames[tag] = true
+		}
+	}
+	if len(renames) == 0 {
+		return nil, fmt.Errorf("No tags to rename to '%s'", newTag)
+	}
+	return renames, nil
+}
+
+func renameTagsIn(specs []*parser.Specification, renames map[string]bool, newTag string) []*fileChange {
+	changes := make([]*fileChange, 0)
+	for _, spec := range specs {
+		renamed := make([]*parser.Tags, 0)
+		if renameTag(spec.Tags, renames, newTag) {
+			renamed = append(renamed, spec.Tags)
+		}
+		for _, scenario := range spec.Scenarios {
+			if renameTag(scenario.Tags, renames, newTag) {
+				renamed = append(renamed, scenario.Tags)
+			}
+		}
+		if len(renamed) == 0 {
+			continue
+		}
+		formatted, ok := formatTagsInFile(spec.FileName, renamed)
+		if !ok {
+			formatted = formatter.FormatSpecification(spec)
+		}
+		changes = append(changes, &fileChange{fileName: spec.FileName, content: formatted})
+	}
+	sort.Sort(byFileName(changes))
+	return changes
+}
+
+// renameTag replaces the renamed tags by newTag, keeping only the first occurrence of newTag.
+func renameTag(tags *parser.Tags, renames map[string]bool, newTag string) bool {
+	if tags == nil {
+		return false
+	}
+	changed := false
+	values := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, tag := range tags.Values {
+		if renames[tag] {
+			tag = newTag
+			changed = true
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			values = append(values, tag)
+		}
+	}
+	if changed {
+		tags.Values = values
+	}
+	return changed
+}
+
+func formatTagsInFile(fileName string, tags []*parser.Tags) (string, bool) {
+	source, err := common.ReadFileContents(fileName)
+	if err != nil {
+		return "", false
+	}
+	return formatter.FormatTagsIn(source, tags)
+}
+
+func RefactorTags(oldTags, newTag string) {
+	printRefactoringSummary(RenameTags(oldTags, newTag, false))
+}
+
+// PreviewRefactorTags prints the changes renaming tags would make as diffs, without changing any file.
+func PreviewRefactorTags(oldTags, newTag string) {
+	printPreview(RenameTags(oldTags, newTag, true))
+}
+
+// tagUsages counts the specs and scenarios each tag is used on.
+func tagUsages(specs []*parser.Specification) map[string]int {
+	usages := make(map[string]int)
+	count := func(tags *parser.Tags) {
+		if tags == nil {
+			return
+		}
+		for _, tag := range tags.Values {
+			usages[tag]++
+		}
+	}
+	for _, spec := range specs {
+		count(spec.Tags)
+		for _, scenario := range spec.Scenarios {
+			count(scenario.Tags)
+		}
+	}
+	return usages
+}
+
+func tagWords(tag string) []string {
+	return strings.FieldsFunc(strings.ToLower(tag), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// areNearDuplicateTags is true for tags that differ only in case or separators (Smoke, smoke_test, smoke-test),
+// where one extends the other (smoke, smoke-test) or that are a single typo apart (regression, regresion).
+func areNearDuplicateTags(tag1, tag2 string) bool {
+	words1, words2 := tagWords(tag1), tagWords(tag2)
+	joined1, joined2 := strings.Join(words1, ""), strings.Join(words2, "")
+	if joined1 == "" || joined2 == "" {
+		return false
+	}
+	if joined1 == joined2 {
+		return true
+	}
+	_, _, keyValue1 := parser.SplitKeyValueTag(tag1)
+	_, _, keyValue2 := parser.SplitKeyValueTag(tag2)
+	if !keyValue1 && !keyValue2 && (hasWordPrefix(words1, words2) || hasWordPrefix(words2, words1)) {
+		return true
+	}
+	return len(joined1) >= 5 && len(joined2) >= 5 && editDistance(joined1, joined2) <= 1
+}
+
+func hasWordPrefix(words, prefix []string) bool {
+	if len(prefix) >= len(words) {
+		return false
+	}
+	for i := range prefix {
+		if words[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	previous := make([]int, len(t)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		current := make([]int, len(t)+1)
+		current[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			current[j] = smallest(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(t)]
+}
+
+func smallest(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+// nearDuplicateTags groups tags that are near duplicates of each other, the most used tag of a group first.
+func nearDuplicateTags(usages map[string]int) [][]string {
+	tags := make([]string, 0)
+	for tag := range usages {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	group := make(map[string]int)
+	groups := make([][]string, 0)
+	for i, tag := range tags {
+		if _, ok := group[tag]; !ok {
+			group[tag] = len(groups)
+			groups = append(groups, []string{tag})
+		}
+		for _, other := range tags[i+1:] {
+			if _, ok := group[other]; !ok && areNearDuplicateTags(tag, other) {
+				group[other] = group[tag]
+				groups[group[tag]] = append(groups[group[tag]], other)
+			}
+		}
+	}
+	duplicates := make([][]string, 0)
+	for _, g := range groups {
+		if len(g) > 1 {
+			sort.Sort(byUsage{g, usages})
+			duplicates = append(duplicates, g)
+		}
+	}
+	return duplicates
+}
+
+type byUsage struct {
+	tags   []string
+	usages map[string]int
+}
+
+func (s byUsage) Len() int      { return len(s.tags) }
+func (s byUsage) Swap(i, j int) { s.tags[i], s.tags[j] = s.tags[j], s.tags[i] }
+func (s byUsage) Less(i, j int) bool {
+	if s.usages[s.tags[i]] != s.usages[s.tags[j]] {
+		return s.usages[s.tags[i]] > s.usages[s.tags[j]]
+	}
+	return s.tags[i] < s.tags[j]
+}
+
+// PrintTagReport lists the tags used in the project, the tags used on a single spec or scenario and groups of
+// near duplicate tags along with the command that merges them. Given a tag expression, it also lists the tags
+// the expression does not use.
+func PrintTagReport(tagExpression string) {
+	specs, parseResults := parser.FindSpecs(filepath.Join(config.ProjectRoot, common.SpecsDirectoryName), &parser.ConceptDictionary{})
+	for _, parseResult := range parseResults {
+		if !parseResult.Ok {
+			logger.Fatal("%s", parseResult.Error())
+		}
+	}
+	usages := tagUsages(specs)
+	tags := make([]string, 0)
+	for tag := range usages {
+		tags = append(tags, tag)
+	}
+	sort.Sort(byUsage{tags, usages})
+	logger.Info("%d tags used.\n", len(tags))
+	for _, tag := range tags {
+		logger.Info("  %-30s %d\n", tag, usages[tag])
+	}
+	usedOnce := make([]string, 0)
+	for _, tag := range tags {
+		if usages[tag] == 1 {
+			usedOnce = append(usedOnce, tag)
+		}
+	}
+	if len(usedOnce) > 0 {
+		sort.Strings(usedOnce)
+		logger.Info("\nTags used only once: %s\n", strings.Join(usedOnce, ", "))
+	}
+	if tagExpression != "" {
+		unused, err := tagsNotUsedBy(tagExpression, tags)
+		if err != nil {
+			logger.Fatal(err.Error())
+		}
+		if len(unused) > 0 {
+			logger.Info("\nTags not used by %s: %s\n", tagExpression, strings.Join(unused, ", "))
+		}
+	}
+	duplicates := nearDuplicateTags(usages)
+	if len(duplicates) > 0 {
+		logger.Info("\nPossible duplicate tags:\n")
+	}
+	for _, group := range duplicates {
+		logger.Info("  %s\n", strings.Join(group, ", "))
+		logger.Info("    gauge --refactor-tag \"%s\" \"%s\"\n", strings.Join(group[1:], ","), group[0])
+	}
+}
+
+// tagsNotUsedBy returns the tags, in sorted order, which the tag expression does not refer to.
+func tagsNotUsedBy(tagExpression string, tags []string) ([]string, error) {
+	used, err := filter.TagsUsedBy(tagExpression, tags)
+	if err != nil {
+		return nil, err
+	}
+	isUsed := make(map[string]bool)
+	for _, tag := range used {
+		isUsed[tag] = true
+	}
+	unused := make([]string, 0)
+	for _, tag := range tags {
+		if !isUsed[tag] {
+			unused = append(unused, tag)
+		}
+	}
+	sort.Strings(unused)
+	return unused, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestRenameTagsMergesTagsInSpecsAndScenarios(c *C) {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	projectRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = projectRoot }()
+	specsDir := filepath.Join(dir, common.SpecsDirectoryName)
+	c.Assert(os.MkdirAll(specsDir, 0755), IsNil)
+	specFile := filepath.Join(specsDir, "example.spec")
+	specText := "Spec Heading\n============\ntags: Smoke, api\n\n* step  with   spaces\n\n## Scenario\ntags: smoke-test, smoke,  fast\n* another step\n"
+	c.Assert(ioutil.WriteFile(specFile, []byte(specText), 0644), IsNil)
+	otherFile := filepath.Join(specsDir, "other.spec")
+	otherText := "Other\n=====\ntags: api\n\n## Scenario\n* step\n"
+	c.Assert(ioutil.WriteFile(otherFile, []byte(otherText), 0644), IsNil)
+
+	result := RenameTags("Smoke, smoke-test", "smoke", false)
+
+	c.Assert(result.Success, Equals, true)
+	c.Assert(result.specsChanged, DeepEquals, []string{specFile})
+	contents, _ := common.ReadFileContents(specFile)
+	c.Assert(contents, Equals, "Spec Heading\n============\ntags: smoke, api\n\n* step  with   spaces\n\n## Scenario\ntags: smoke, fast\n* another step\n")
+	contents, _ = common.ReadFileContents(otherFile)
+	c.Assert(contents, Equals, otherText)
+}
+
+func (s *MySuite) TestRenameTagsRejectsInvalidNewTag(c *C) {
+	result := RenameTags("smoke", "a,b", true)
+
+	c.Assert(result.Success, Equals, false)
+	c.Assert(result.Errors, DeepEquals, []string{"Invalid tag name 'a,b'"})
+}
+
+func (s *MySuite) TestNearDuplicateTags(c *C) {
+	usages := map[string]int{"smoke": 10, "Smoke": 2, "smoke-test": 1, "regression": 4, "regresion": 1, "api": 3, "owner:payments": 2, "owner:search": 1, "fast": 1}
+
+	duplicates := nearDuplicateTags(usages)
+
+	c.Assert(duplicates, DeepEquals, [][]string{{"smoke", "Smoke", "smoke-test"}, {"regression", "regresion"}})
+}
+
+func (s *MySuite) TestAreNearDuplicateTags(c *C) {
+	c.Assert(areNearDuplicateTags("smoke_test", "SmokeTest"), Equals, true)
+	c.Assert(areNearDuplicateTags("smoke", "smoke-test"), Equals, true)
+	c.Assert(areNearDuplicateTags("owner", "owner:payments"), Equals, false)
+	c.Assert(areNearDuplicateTags("ui", "api"), Equals, false)
+}
+
+func (s *MySuite) TestTagsNotUsedByTagExpression(c *C) {
+	tags := []string{"smoke", "login", "owner:payments", "owner:search", "slow", "wip"}
+
+	unused, err := tagsNotUsedBy("smoke & !wip | owner=pay* | log*", tags)
+
+	c.Assert(err, IsNil)
+	c.Assert(unused, DeepEquals, []string{"owner:search", "slow"})
+}
@@ -0,0 +1,181 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+)
+
+const (
+	undoDirName  = ".gauge"
+	undoFileName = "refactor.undo"
+)
+
+// fileSnapshot holds a file as it was before a refactoring wrote or removed it, and as it was written.
+type fileSnapshot struct {
+	FileName string
+	Existed  bool
+	Removed  bool
+	Before   string
+	After    string
+}
+
+// transaction writes all the files changed by a refactoring, so that they can be restored together if
+// any write fails, or later by gauge --refactor-undo.
+type transaction struct {
+	Snapshots []*fileSnapshot
+}
+
+func (t *transaction) write(change *fileChange) error {
+	before, err := common.ReadFileContents(change.fileName)
+	existed := err == nil
+	if !existed && common.FileExists(change.fileName) {
+		return fmt.Errorf("Failed to read %s. %s", change.fileName, err)
+	}
+	t.Snapshots = append(t.Snapshots, &fileSnapshot{FileName: change.fileName, Existed: existed, Removed: change.removed, Before: before, After: change.content})
+	if change.removed {
+		return os.Remove(change.fileName)
+	}
+	return common.SaveFile(change.fileName, change.content, false)
+}
+
+// commit writes the changes in order and restores every file written so far when one of them fails.
+func (t *transaction) commit(changes []*fileChange) error {
+	for _, change := range changes {
+		if err := t.write(change); err != nil {
+			if rollbackErr := t.rollback(); rollbackErr != nil {
+				return fmt.Errorf("%s. Restoring the changed files failed too: %s", err, rollbackErr)
+			}
+			return fmt.Errorf("%s. All the changed files have been restored.", err)
+		}
+	}
+	return nil
+}
+
+func (t *transaction) rollback() error {
+	var failed []string
+	for i := len(t.Snapshots) - 1; i >= 0; i-- {
+		snapshot := t.Snapshots[i]
+		var err error
+		if snapshot.Existed {
+			err = common.SaveFile(snapshot.FileName, snapshot.Before, false)
+		} else {
+			err = os.Remove(snapshot.FileName)
+		}
+		if err != nil && !os.IsNotExist(err) {
+			failed = append(failed, snapshot.FileName)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("could not restore %s", failed)
+	}
+	return nil
+}
+
+func (t *transaction) fileNames() []string {
+	names := make([]string, 0)
+	for _, snapshot := range t.latestSnapshots() {
+		names = append(names, snapshot.FileName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// latestSnapshots keeps the last snapshot of each file, which holds what the transaction finally wrote to it.
+func (t *transaction) latestSnapshots() []*fileSnapshot {
+	latest := make(map[string]int)
+	for i, snapshot := range t.Snapshots {
+		latest[snapshot.FileName] = i
+	}
+	snapshots := make([]*fileSnapshot, 0)
+	for i, snapshot := range t.Snapshots {
+		if latest[snapshot.FileName] == i {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	return snapshots
+}
+
+func undoFile() string {
+	return filepath.Join(config.ProjectRoot, undoDirName, undoFileName)
+}
+
+// keepForUndo stores the transaction, replacing the one kept by the previous refactoring.
+func (t *transaction) keepForUndo() error {
+	file := undoFile()
+	if err := os.MkdirAll(filepath.Dir(file), common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return gob.NewEncoder(f).Encode(t)
+}
+
+func lastTransaction() (*transaction, error) {
+	f, err := os.Open(undoFile())
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("There is no refactoring to undo.")
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	t := &transaction{}
+	if err := gob.NewDecoder(f).Decode(t); err != nil {
+		return nil, fmt.Errorf("Failed to read the last refactoring. %s", err)
+	}
+	return t, nil
+}
+
+// undoLastTransaction restores the files changed by the last refactoring. Files changed since then are not touched,
+// so that undo does not lose later edits.
+func undoLastTransaction() ([]string, error) {
+	t, err := lastTransaction()
+	if err != nil {
+		return nil, err
+	}
+	var modified []string
+	for _, snapshot := range t.latestSnapshots() {
+		if snapshot.Removed {
+			if common.FileExists(snapshot.FileName) {
+				modified = append(modified, snapshot.FileName)
+			}
+		} else if contents, err := common.ReadFileContents(snapshot.FileName); err != nil || contents != snapshot.After {
+			modified = append(modified, snapshot.FileName)
+		}
+	}
+	if len(modified) > 0 {
+		sort.Strings(modified)
+		return nil, fmt.Errorf("Cannot undo the last refactoring, these files have changed since: %s", modified)
+	}
+	if err := t.rollback(); err != nil {
+		return nil, fmt.Errorf("Failed to undo the last refactoring, %s", err)
+	}
+	os.Remove(undoFile())
+	return t.fileNames(), nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package refactor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestTransactionRestoresWrittenFilesWhenAWriteFails(c *C) {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	existing := filepath.Join(dir, "existing.spec")
+	c.Assert(ioutil.WriteFile(existing, []byte("old"), 0644), IsNil)
+	created := filepath.Join(dir, "created.cpt")
+	changes := []*fileChange{
+		&fileChange{fileName: existing, content: "new"},
+		&fileChange{fileName: created, content: "new"},
+		&fileChange{fileName: filepath.Join(dir, "missing", "step_impl.java"), content: "new"},
+	}
+
+	err = (&transaction{}).commit(changes)
+
+	c.Assert(err, NotNil)
+	contents, _ := common.ReadFileContents(existing)
+	c.Assert(contents, Equals, "old")
+	c.Assert(common.FileExists(created), Equals, false)
+}
+
+func (s *MySuite) TestUndoRestoresFilesChangedByLastTransaction(c *C) {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	projectRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = projectRoot }()
+	existing := filepath.Join(dir, "existing.spec")
+	c.Assert(ioutil.WriteFile(existing, []byte("old"), 0644), IsNil)
+	created := filepath.Join(dir, "created.cpt")
+	tx := &transaction{}
+	c.Assert(tx.commit([]*fileChange{&fileChange{fileName: existing, content: "new"}, &fileChange{fileName: created, content: "new"}}), IsNil)
+	c.Assert(tx.keepForUndo(), IsNil)
+
+	files, err := undoLastTransaction()
+
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{created, existing})
+	contents, _ := common.ReadFileContents(existing)
+	c.Assert(contents, Equals, "old")
+	c.Assert(common.FileExists(created), Equals, false)
+	_, err = undoLastTransaction()
+	c.Assert(err, ErrorMatches, "There is no refactoring to undo.")
+}
+
+func (s *MySuite) TestUndoIsRefusedWhenAFileChangedSinceRefactoring(c *C) {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	projectRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = projectRoot }()
+	file := filepath.Join(dir, "example.spec")
+	c.Assert(ioutil.WriteFile(file, []byte("old"), 0644), IsNil)
+	tx := &transaction{}
+	c.Assert(tx.commit([]*fileChange{&fileChange{fileName: file, content: "new"}}), IsNil)
+	c.Assert(tx.keepForUndo(), IsNil)
+	c.Assert(ioutil.WriteFile(file, []byte("edited"), 0644), IsNil)
+
+	_, err = undoLastTransaction()
+
+	c.Assert(err, ErrorMatches, "Cannot undo the last refactoring.*example.spec.*")
+	contents, _ := common.ReadFileContents(file)
+	c.Assert(contents, Equals, "edited")
+}
+
+func (s *MySuite) TestUndoRestoresAFileWrittenTwiceInOneTransaction(c *C) {
+	dir, err := ioutil.TempDir("", "refactor")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	projectRoot := config.ProjectRoot
+	config.ProjectRoot = dir
+	defer func() { config.ProjectRoot = projectRoot }()
+	existing := filepath.Join(dir, "StepImpl.java")
+	c.Assert(ioutil.WriteFile(existing, []byte("old"), 0644), IsNil)
+	tx := &transaction{}
+	c.Assert(tx.commit([]*fileChange{&fileChange{fileName: existing, content: "first"}}), IsNil)
+	c.Assert(tx.commit([]*fileChange{&fileChange{fileName: existing, content: "second"}}), IsNil)
+	c.Assert(tx.keepForUndo(), IsNil)
+
+	files, err := undoLastTransaction()
+
+	c.Assert(err, IsNil)
+	c.Assert(files, DeepEquals, []string{existing})
+	contents, _ := common.ReadFileContents(existing)
+	c.Assert(contents, Equals, "old")
+}
@@ -99,6 +99,17 @@ func GetSpecFiles(specSource string) []string {
 	return specFiles
 }
 
+func GetConceptFiles(conceptSource string) []string {
+	var conceptFiles []string
+	if common.DirExists(conceptSource) {
+		conceptFiles = append(conceptFiles, FindConceptFilesIn(conceptSource)...)
+	} else if common.FileExists(conceptSource) && IsValidConceptExtension(conceptSource) {
+		conceptFile, _ := filepath.Abs(conceptSource)
+		conceptFiles = append(conceptFiles, conceptFile)
+	}
+	return conceptFiles
+}
+
 func SaveFile(fileName string, content string, backup bool) {
 	err := common.SaveFile(fileName, content, backup)
 	if err != nil {
@@ -0,0 +1,122 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// Projects declare their own resolvers as properties of the form
+// special_param_resolver_<prefix> = <command>, for commands printing a string, and
+// special_table_resolver_<prefix> = <command>, for commands printing a CSV table.
+// The value of the special param is passed as the last argument of the command.
+const (
+	stringResolverPropertyPrefix = "special_param_resolver_"
+	tableResolverPropertyPrefix  = "special_table_resolver_"
+)
+
+// BuiltInSpecialParamTypes are the prefixes of the special params gauge resolves itself, e.g. file in <file:notes.txt>.
+var BuiltInSpecialParamTypes = []string{"file", "table", "env", "json", "text"}
+
+// ResolverCommand is a command declared by the project to resolve the special params of a prefix.
+type ResolverCommand struct {
+	Args    []string
+	IsTable bool
+}
+
+// IsSpecialParamType tells whether params of the form <prefix:value> are resolved as special params, either by
+// gauge or by a resolver the project declares. Any other <prefix:value> param is a dynamic param.
+func IsSpecialParamType(prefix string) bool {
+	for _, paramType := range BuiltInSpecialParamTypes {
+		if paramType == prefix {
+			return true
+		}
+	}
+	commands, _ := ResolverCommands()
+	_, ok := commands[prefix]
+	return ok
+}
+
+// ResolverCommands reads the resolver commands declared in the environment by prefix, along with an error for
+// each declaration that cannot be read.
+func ResolverCommands() (map[string]*ResolverCommand, []error) {
+	commands := make(map[string]*ResolverCommand)
+	errs := make([]error, 0)
+	for _, variable := range os.Environ() {
+		keyValue := strings.SplitN(variable, "=", 2)
+		if len(keyValue) != 2 || strings.TrimSpace(keyValue[1]) == "" {
+			continue
+		}
+		isTable := strings.HasPrefix(keyValue[0], tableResolverPropertyPrefix)
+		if !isTable && !strings.HasPrefix(keyValue[0], stringResolverPropertyPrefix) {
+			continue
+		}
+		args, err := splitCommand(keyValue[1])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Ignoring resolver %s: %s", keyValue[0], err.Error()))
+			continue
+		}
+		prefix := strings.TrimPrefix(strings.TrimPrefix(keyValue[0], stringResolverPropertyPrefix), tableResolverPropertyPrefix)
+		commands[prefix] = &ResolverCommand{Args: args, IsTable: isTable}
+	}
+	return commands, errs
+}
+
+// splitCommand splits a resolver command into arguments like a shell does: quotes group words, and a
+// backslash escapes the next character outside single quotes.
+func splitCommand(command string) ([]string, error) {
+	var args []string
+	var arg bytes.Buffer
+	inArg, escaped := false, false
+	var quote rune
+	for _, r := range command {
+		switch {
+		case escaped:
+			arg.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			arg.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote, inArg = r, true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("Unterminated quote or escape in command '%s'", command)
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
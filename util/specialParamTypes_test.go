@@ -0,0 +1,48 @@
+// Copyright 2015 ThoughtWorks, Inc.
+
+// This file is part of Gauge.
+
+// Gauge is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// Gauge is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+
+// You should have received a copy of the GNU General Public License
+// along with Gauge.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"os"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestIsSpecialParamTypeIncludesDeclaredResolvers(c *C) {
+	os.Setenv("special_table_resolver_users", "fetch users")
+	os.Setenv("special_param_resolver_broken", "echo \"hello")
+	defer os.Unsetenv("special_table_resolver_users")
+	defer os.Unsetenv("special_param_resolver_broken")
+
+	c.Assert(IsSpecialParamType("file"), Equals, true)
+	c.Assert(IsSpecialParamType("users"), Equals, true)
+	c.Assert(IsSpecialParamType("broken"), Equals, false)
+	c.Assert(IsSpecialParamType("name"), Equals, false)
+	commands, errs := ResolverCommands()
+	c.Assert(commands["users"], DeepEquals, &ResolverCommand{Args: []string{"fetch", "users"}, IsTable: true})
+	c.Assert(len(errs), Equals, 1)
+}
+
+func (s *MySuite) TestSplitCommandHonoursQuotesAndEscapes(c *C) {
+	args, err := splitCommand(`python "scripts/get user.py" --name 'a b' c\ d`)
+	c.Assert(err, IsNil)
+	c.Assert(args, DeepEquals, []string{"python", "scripts/get user.py", "--name", "a b", "c d"})
+
+	_, err = splitCommand(`echo "hello`)
+	c.Assert(err, NotNil)
+}